@@ -0,0 +1,104 @@
+package bigqueryGoDate
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+)
+
+// The sizes of the binary encodings produced by MarshalBinary.
+const (
+	dateBinaryLen     = 4
+	timeBinaryLen     = 7
+	dateTimeBinaryLen = dateBinaryLen + timeBinaryLen
+)
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface. The
+// encoding is 4 bytes: the year as a big-endian int16, then the month and
+// the day as one byte each. It returns an error if a field does not fit,
+// which cannot happen for dates in BigQuery's range.
+func (d Date) MarshalBinary() ([]byte, error) {
+	return d.appendBinary(make([]byte, 0, dateBinaryLen))
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for
+// the encoding produced by MarshalBinary.
+func (d *Date) UnmarshalBinary(data []byte) error {
+	if len(data) != dateBinaryLen {
+		return fmt.Errorf("invalid Date binary encoding: %d bytes, want %d", len(data), dateBinaryLen)
+	}
+	*d = dateFromBinary(data)
+	return nil
+}
+
+func (d Date) appendBinary(b []byte) ([]byte, error) {
+	if d.Year < math.MinInt16 || d.Year > math.MaxInt16 || d.Month < 0 || d.Month > math.MaxUint8 || d.Day < 0 || d.Day > math.MaxUint8 {
+		return nil, fmt.Errorf("cannot encode %v: field out of range for binary encoding", d)
+	}
+	b = binary.BigEndian.AppendUint16(b, uint16(int16(d.Year)))
+	return append(b, byte(d.Month), byte(d.Day)), nil
+}
+
+func dateFromBinary(b []byte) Date {
+	return Date{
+		Year:  int(int16(binary.BigEndian.Uint16(b))),
+		Month: time.Month(b[2]),
+		Day:   int(b[3]),
+	}
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface. The
+// encoding is 7 bytes: the hour, minute and second as one byte each, then
+// the nanosecond as a big-endian uint32. It returns an error if a field
+// does not fit, which cannot happen for a valid Time.
+func (t Time) MarshalBinary() ([]byte, error) {
+	return t.appendBinary(make([]byte, 0, timeBinaryLen))
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for
+// the encoding produced by MarshalBinary.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	if len(data) != timeBinaryLen {
+		return fmt.Errorf("invalid Time binary encoding: %d bytes, want %d", len(data), timeBinaryLen)
+	}
+	*t = timeFromBinary(data)
+	return nil
+}
+
+func (t Time) appendBinary(b []byte) ([]byte, error) {
+	if t.Hour < 0 || t.Hour > math.MaxUint8 || t.Minute < 0 || t.Minute > math.MaxUint8 || t.Second < 0 || t.Second > math.MaxUint8 || t.Nanosecond < 0 || int64(t.Nanosecond) > math.MaxUint32 {
+		return nil, fmt.Errorf("cannot encode %v: field out of range for binary encoding", t)
+	}
+	b = append(b, byte(t.Hour), byte(t.Minute), byte(t.Second))
+	return binary.BigEndian.AppendUint32(b, uint32(t.Nanosecond)), nil
+}
+
+func timeFromBinary(b []byte) Time {
+	return Time{
+		Hour:       int(b[0]),
+		Minute:     int(b[1]),
+		Second:     int(b[2]),
+		Nanosecond: int(binary.BigEndian.Uint32(b[3:])),
+	}
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface. The
+// encoding is the binary encoding of dt.Date followed by that of dt.Time.
+func (dt DateTime) MarshalBinary() ([]byte, error) {
+	b, err := dt.Date.appendBinary(make([]byte, 0, dateTimeBinaryLen))
+	if err != nil {
+		return nil, err
+	}
+	return dt.Time.appendBinary(b)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for
+// the encoding produced by MarshalBinary.
+func (dt *DateTime) UnmarshalBinary(data []byte) error {
+	if len(data) != dateTimeBinaryLen {
+		return fmt.Errorf("invalid DateTime binary encoding: %d bytes, want %d", len(data), dateTimeBinaryLen)
+	}
+	*dt = DateTime{Date: dateFromBinary(data), Time: timeFromBinary(data[dateBinaryLen:])}
+	return nil
+}
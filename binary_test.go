@@ -0,0 +1,86 @@
+package bigqueryGoDate
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+	"time"
+)
+
+func TestGobRoundTrip(t *testing.T) {
+	type row struct {
+		D  Date
+		T  Time
+		DT DateTime
+	}
+	for _, in := range []row{
+		{Date{2023, 5, 1}, Time{13, 45, 0, 500000000}, DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 123456789}}},
+		{Date{1, 1, 1}, Time{}, DateTime{Date{1, 1, 1}, Time{}}},
+		{Date{9999, 12, 31}, Time{23, 59, 59, 999999999}, DateTime{Date{9999, 12, 31}, Time{23, 59, 59, 999999999}}},
+		{Date{-300, 0, 0}, Time{}, DateTime{}}, // fields outside the civil range survive too
+	} {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+			t.Fatal(err)
+		}
+		var out row
+		if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+			t.Fatal(err)
+		}
+		if out != in {
+			t.Errorf("gob round trip = %+v, want %+v", out, in)
+		}
+	}
+}
+
+func TestMarshalBinaryLength(t *testing.T) {
+	dt := DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 5}}
+	for _, tc := range []struct {
+		name string
+		got  func() ([]byte, error)
+		want int
+	}{
+		{"Date", dt.Date.MarshalBinary, 4},
+		{"Time", dt.Time.MarshalBinary, 7},
+		{"DateTime", dt.MarshalBinary, 11},
+	} {
+		if b, err := tc.got(); err != nil || len(b) != tc.want {
+			t.Errorf("%s.MarshalBinary() = %x, %v; want %d bytes", tc.name, b, err, tc.want)
+		}
+	}
+}
+
+func TestMarshalBinaryErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		fn   func() ([]byte, error)
+	}{
+		{"Date year", Date{40000, 1, 1}.MarshalBinary},
+		{"Date month", Date{2023, -1, 1}.MarshalBinary},
+		{"Date day", Date{2023, 1, 256}.MarshalBinary},
+		{"Time hour", Time{Hour: 256}.MarshalBinary},
+		{"Time nanosecond", Time{Nanosecond: -1}.MarshalBinary},
+		{"DateTime date", DateTime{Date: Date{2023, time.Month(300), 1}}.MarshalBinary},
+		{"DateTime time", DateTime{Time: Time{Minute: -1}}.MarshalBinary},
+	} {
+		if b, err := tc.fn(); err == nil {
+			t.Errorf("%s out of range: MarshalBinary() = %x, want an error", tc.name, b)
+		}
+	}
+}
+
+func TestUnmarshalBinaryLength(t *testing.T) {
+	// None of these is the length of any of the three encodings.
+	for _, n := range []int{0, 3, 5, 10, 12} {
+		data := make([]byte, n)
+		if err := new(Date).UnmarshalBinary(data); err == nil {
+			t.Errorf("Date.UnmarshalBinary of %d bytes succeeded, want an error", n)
+		}
+		if err := new(Time).UnmarshalBinary(data); err == nil {
+			t.Errorf("Time.UnmarshalBinary of %d bytes succeeded, want an error", n)
+		}
+		if err := new(DateTime).UnmarshalBinary(data); err == nil {
+			t.Errorf("DateTime.UnmarshalBinary of %d bytes succeeded, want an error", n)
+		}
+	}
+}
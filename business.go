@@ -0,0 +1,54 @@
+package bigqueryGoDate
+
+import "time"
+
+// A Calendar reports which dates are holidays. Saturdays and Sundays are
+// never business days, so a Calendar only needs to describe the other
+// non-working days.
+type Calendar interface {
+	IsHoliday(d Date) bool
+}
+
+// IsBusinessDay reports whether d is neither a weekend day nor a holiday in
+// cal. A nil cal has no holidays.
+func (d Date) IsBusinessDay(cal Calendar) bool {
+	switch d.Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+	return cal == nil || !cal.IsHoliday(d)
+}
+
+// AddBusinessDays returns the date that is n business days after d, as
+// defined by IsBusinessDay. n can also be negative to go into the past.
+// If n is zero, d is returned unchanged even if it is not a business day.
+func (d Date) AddBusinessDays(n int, cal Calendar) Date {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		d = d.AddDays(step)
+		if d.IsBusinessDay(cal) {
+			n--
+		}
+	}
+	return d
+}
+
+// BusinessDaysBefore returns the date that is n business days before d.
+// It is equivalent to d.AddBusinessDays(-n, cal).
+func (d Date) BusinessDaysBefore(n int, cal Calendar) Date {
+	return d.AddBusinessDays(-n, cal)
+}
+
+// LastBusinessDayOfMonth returns the last business day, as defined by
+// IsBusinessDay, of the month containing d. It starts from the last calendar
+// day of the month and steps backward over weekends and holidays.
+func (d Date) LastBusinessDayOfMonth(cal Calendar) Date {
+	last := d.EndOfMonth()
+	for !last.IsBusinessDay(cal) {
+		last = last.AddDays(-1)
+	}
+	return last
+}
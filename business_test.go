@@ -0,0 +1,81 @@
+package bigqueryGoDate
+
+import "testing"
+
+// holidays is a Calendar backed by a set of dates.
+type holidays map[Date]bool
+
+func (h holidays) IsHoliday(d Date) bool { return h[d] }
+
+// easter2024 has the English bank holidays around Easter 2024: Good
+// Friday, March 29, and Easter Monday, April 1, which with the weekend make
+// a four-day break.
+var easter2024 = holidays{{2024, 3, 29}: true, {2024, 4, 1}: true}
+
+func TestIsBusinessDay(t *testing.T) {
+	for _, tc := range []struct {
+		d    Date
+		cal  Calendar
+		want bool
+	}{
+		{Date{2024, 3, 28}, nil, true},
+		{Date{2024, 3, 29}, nil, true},
+		{Date{2024, 3, 29}, easter2024, false},
+		{Date{2024, 3, 30}, nil, false}, // Saturday
+		{Date{2024, 3, 31}, nil, false}, // Sunday
+		{Date{2024, 4, 1}, easter2024, false},
+		{Date{2024, 4, 2}, easter2024, true},
+	} {
+		if got := tc.d.IsBusinessDay(tc.cal); got != tc.want {
+			t.Errorf("%v.IsBusinessDay(%v) = %v, want %v", tc.d, tc.cal, got, tc.want)
+		}
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	for _, tc := range []struct {
+		d    Date
+		n    int
+		cal  Calendar
+		want Date
+	}{
+		{Date{2024, 3, 25}, 1, nil, Date{2024, 3, 26}},
+		{Date{2024, 3, 22}, 1, nil, Date{2024, 3, 25}},  // Friday to Monday
+		{Date{2024, 3, 23}, 1, nil, Date{2024, 3, 25}},  // Saturday to Monday
+		{Date{2024, 3, 25}, 5, nil, Date{2024, 4, 1}},   // a full week
+		{Date{2024, 3, 25}, -1, nil, Date{2024, 3, 22}}, // Monday back to Friday
+		{Date{2024, 3, 24}, -1, nil, Date{2024, 3, 22}},
+		{Date{2024, 3, 30}, 0, nil, Date{2024, 3, 30}}, // unchanged though a Saturday
+		// Across the Easter long weekend.
+		{Date{2024, 3, 28}, 1, easter2024, Date{2024, 4, 2}},
+		{Date{2024, 4, 2}, -1, easter2024, Date{2024, 3, 28}},
+		{Date{2024, 3, 27}, 3, easter2024, Date{2024, 4, 3}},
+		{Date{2024, 3, 29}, 1, easter2024, Date{2024, 4, 2}}, // starting on a holiday
+		{Date{2024, 3, 29}, -1, easter2024, Date{2024, 3, 28}},
+	} {
+		if got := tc.d.AddBusinessDays(tc.n, tc.cal); got != tc.want {
+			t.Errorf("%v.AddBusinessDays(%d) = %v, want %v", tc.d, tc.n, got, tc.want)
+		}
+		if got := tc.d.BusinessDaysBefore(-tc.n, tc.cal); got != tc.want {
+			t.Errorf("%v.BusinessDaysBefore(%d) = %v, want %v", tc.d, -tc.n, got, tc.want)
+		}
+	}
+}
+
+func TestLastBusinessDayOfMonth(t *testing.T) {
+	for _, tc := range []struct {
+		d    Date
+		cal  Calendar
+		want Date
+	}{
+		{Date{2024, 1, 15}, nil, Date{2024, 1, 31}},        // a Wednesday
+		{Date{2024, 3, 1}, nil, Date{2024, 3, 29}},         // the 31st is a Sunday
+		{Date{2024, 3, 31}, easter2024, Date{2024, 3, 28}}, // Good Friday as well
+		{Date{2024, 2, 1}, nil, Date{2024, 2, 29}},
+		{Date{2024, 8, 1}, holidays{{2024, 8, 30}: true}, Date{2024, 8, 29}},
+	} {
+		if got := tc.d.LastBusinessDayOfMonth(tc.cal); got != tc.want {
+			t.Errorf("%v.LastBusinessDayOfMonth() = %v, want %v", tc.d, got, tc.want)
+		}
+	}
+}
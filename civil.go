@@ -0,0 +1,34 @@
+package bigqueryGoDate
+
+import "cloud.google.com/go/civil"
+
+// Civil returns d as a civil.Date, the type used by the BigQuery client.
+func (d Date) Civil() civil.Date {
+	return civil.Date{Year: d.Year, Month: d.Month, Day: d.Day}
+}
+
+// DateFromCivil returns the Date equivalent to c.
+func DateFromCivil(c civil.Date) Date {
+	return Date{Year: c.Year, Month: c.Month, Day: c.Day}
+}
+
+// Civil returns t as a civil.Time, the type used by the BigQuery client.
+func (t Time) Civil() civil.Time {
+	return civil.Time{Hour: t.Hour, Minute: t.Minute, Second: t.Second, Nanosecond: t.Nanosecond}
+}
+
+// TimeFromCivil returns the Time equivalent to c.
+func TimeFromCivil(c civil.Time) Time {
+	return Time{Hour: c.Hour, Minute: c.Minute, Second: c.Second, Nanosecond: c.Nanosecond}
+}
+
+// Civil returns dt as a civil.DateTime, the type used by the BigQuery
+// client.
+func (dt DateTime) Civil() civil.DateTime {
+	return civil.DateTime{Date: dt.Date.Civil(), Time: dt.Time.Civil()}
+}
+
+// DateTimeFromCivil returns the DateTime equivalent to c.
+func DateTimeFromCivil(c civil.DateTime) DateTime {
+	return DateTime{Date: DateFromCivil(c.Date), Time: TimeFromCivil(c.Time)}
+}
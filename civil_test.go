@@ -0,0 +1,53 @@
+package bigqueryGoDate
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+)
+
+func TestCivilRoundTrip(t *testing.T) {
+	for _, dt := range []DateTime{
+		{Date{2023, 5, 1}, Time{13, 45, 0, 123456789}},
+		{Date{1, 1, 1}, Time{}},
+		{Date{9999, 12, 31}, Time{23, 59, 59, 999999999}},
+	} {
+		c := dt.Civil()
+		if c.String() != dt.String() {
+			t.Errorf("%v.Civil() = %v", dt, c)
+		}
+		if got := DateTimeFromCivil(c); got != dt {
+			t.Errorf("DateTimeFromCivil(%v) = %v, want %v", c, got, dt)
+		}
+		if got := DateFromCivil(dt.Date.Civil()); got != dt.Date {
+			t.Errorf("DateFromCivil(%v) = %v, want %v", dt.Date.Civil(), got, dt.Date)
+		}
+		if got := TimeFromCivil(dt.Time.Civil()); got != dt.Time {
+			t.Errorf("TimeFromCivil(%v) = %v, want %v", dt.Time.Civil(), got, dt.Time)
+		}
+	}
+}
+
+func TestScanCivil(t *testing.T) {
+	// Every month, so December is not taken for month 0 or 13.
+	for m := time.January; m <= time.December; m++ {
+		c := civil.Date{Year: 2023, Month: m, Day: 1}
+		var d Date
+		if err := d.Scan(c); err != nil || d != (Date{2023, m, 1}) {
+			t.Errorf("Date.Scan(%v) = %v, %v; want 2023-%02d-01", c, d, err, m)
+		}
+	}
+
+	ct := civil.Time{Hour: 13, Minute: 45, Nanosecond: 5}
+	var tm Time
+	if err := tm.Scan(ct); err != nil || tm != (Time{13, 45, 0, 5}) {
+		t.Errorf("Time.Scan(%v) = %v, %v", ct, tm, err)
+	}
+
+	cdt := civil.DateTime{Date: civil.Date{Year: 2023, Month: time.December, Day: 31}, Time: ct}
+	var dt DateTime
+	if err := dt.Scan(cdt); err != nil || dt != DateTimeFromCivil(cdt) {
+		t.Errorf("DateTime.Scan(%v) = %v, %v", cdt, dt, err)
+	}
+}
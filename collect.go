@@ -0,0 +1,36 @@
+package bigqueryGoDate
+
+import (
+	"errors"
+	"fmt"
+)
+
+// A CollectingScanner scans into the Date at Dest without ever failing.
+// When a value cannot be scanned, Dest is set to the zero Date and the
+// error is recorded, so that one bad row does not abort a bulk load. Pass
+// the same CollectingScanner to rows.Scan for every row and inspect Errs
+// or Err when done.
+type CollectingScanner struct {
+	Dest *Date
+	// Errs holds one error per failed scan, in order. Each error names
+	// the zero-based index of the scan that produced it.
+	Errs []error
+	n    int
+}
+
+// Scan implements the database/sql Scanner interface. It always returns
+// nil.
+func (c *CollectingScanner) Scan(value any) error {
+	if err := c.Dest.Scan(value); err != nil {
+		*c.Dest = Date{}
+		c.Errs = append(c.Errs, fmt.Errorf("row %d: %w", c.n, err))
+	}
+	c.n++
+	return nil
+}
+
+// Err returns the recorded errors joined with errors.Join, or nil if every
+// scan succeeded.
+func (c *CollectingScanner) Err() error {
+	return errors.Join(c.Errs...)
+}
@@ -0,0 +1,47 @@
+package bigqueryGoDate
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCollectingScanner(t *testing.T) {
+	var d Date
+	c := &CollectingScanner{Dest: &d}
+	for i, tc := range []struct {
+		v    any
+		want Date
+	}{
+		{"2023-05-01", Date{2023, 5, 1}},
+		{"not a date", Date{}},
+		{"2023-05-02", Date{2023, 5, 2}},
+		{3.5, Date{}},
+	} {
+		d = Date{1999, 1, 1}
+		if err := c.Scan(tc.v); err != nil {
+			t.Errorf("row %d: Scan returned %v, want nil", i, err)
+		}
+		if d != tc.want {
+			t.Errorf("row %d: Scan(%v) set %v, want %v", i, tc.v, d, tc.want)
+		}
+	}
+
+	if len(c.Errs) != 2 {
+		t.Fatalf("Errs = %v, want 2 errors", c.Errs)
+	}
+	for i, prefix := range []string{"row 1: ", "row 3: "} {
+		if !strings.HasPrefix(c.Errs[i].Error(), prefix) {
+			t.Errorf("Errs[%d] = %q, want prefix %q", i, c.Errs[i], prefix)
+		}
+	}
+	err := c.Err()
+	if err == nil || !errors.Is(err, c.Errs[0]) || !errors.Is(err, c.Errs[1]) {
+		t.Errorf("Err() = %v, want both recorded errors joined", err)
+	}
+
+	ok := &CollectingScanner{Dest: &d}
+	if err := ok.Scan("2023-05-01"); err != nil || ok.Err() != nil {
+		t.Errorf("Err() after a good scan = %v, want nil", ok.Err())
+	}
+}
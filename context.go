@@ -0,0 +1,39 @@
+package bigqueryGoDate
+
+import (
+	"context"
+	"time"
+)
+
+type scanLocationKey struct{}
+
+// WithScanLocation returns a copy of ctx carrying loc, the location that
+// ScanDateCtx uses to take the date of a time.Time.
+func WithScanLocation(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, scanLocationKey{}, loc)
+}
+
+// ScanLocation returns the location stored in ctx by WithScanLocation, or
+// nil if there is none.
+func ScanLocation(ctx context.Context) *time.Location {
+	loc, _ := ctx.Value(scanLocationKey{}).(*time.Location)
+	return loc
+}
+
+// ScanDateCtx scans v into dst like Date.Scan, except that a time.Time or
+// non-nil *time.Time is first converted to the location carried by ctx, so
+// its date is the one in that location rather than in the time's own. If
+// ctx carries no location, ScanDateCtx behaves exactly like dst.Scan(v).
+func ScanDateCtx(ctx context.Context, dst *Date, v any) error {
+	if loc := ScanLocation(ctx); loc != nil {
+		switch t := v.(type) {
+		case time.Time:
+			v = t.In(loc)
+		case *time.Time:
+			if t != nil {
+				v = t.In(loc)
+			}
+		}
+	}
+	return dst.Scan(v)
+}
@@ -0,0 +1,45 @@
+package bigqueryGoDate
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestScanDateCtx(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	la := time.FixedZone("PDT", -7*60*60)
+	// 23:30 UTC on May 1 is already May 2 in Tokyo.
+	instant := time.Date(2023, 5, 1, 23, 30, 0, 0, time.UTC)
+
+	if got := ScanLocation(context.Background()); got != nil {
+		t.Errorf("ScanLocation(Background) = %v, want nil", got)
+	}
+	if got := ScanLocation(WithScanLocation(context.Background(), tokyo)); got != tokyo {
+		t.Errorf("ScanLocation = %v, want %v", got, tokyo)
+	}
+
+	for _, tc := range []struct {
+		loc  *time.Location
+		v    any
+		want Date
+	}{
+		{nil, instant, Date{2023, 5, 1}},
+		{tokyo, instant, Date{2023, 5, 2}},
+		{tokyo, &instant, Date{2023, 5, 2}},
+		{la, instant, Date{2023, 5, 1}},
+		{tokyo, instant.In(la), Date{2023, 5, 2}},
+		// Values other than time.Time are scanned as usual.
+		{tokyo, "2023-05-01", Date{2023, 5, 1}},
+		{tokyo, (*time.Time)(nil), Date{}},
+	} {
+		ctx := context.Background()
+		if tc.loc != nil {
+			ctx = WithScanLocation(ctx, tc.loc)
+		}
+		var d Date
+		if err := ScanDateCtx(ctx, &d, tc.v); err != nil || d != tc.want {
+			t.Errorf("ScanDateCtx(%v, %v) = %v, %v; want %v", tc.loc, tc.v, d, err, tc.want)
+		}
+	}
+}
@@ -1,9 +1,13 @@
 package bigqueryGoDate
 
 import (
+	"bytes"
+	"database/sql"
 	"database/sql/driver"
 	"fmt"
+	"math"
 	"reflect"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/civil"
@@ -26,8 +30,25 @@ func DateOf(t time.Time) Date {
 	return d
 }
 
+// NewDateStrict returns the date with the given year, month and day, or an
+// error if they do not form a valid calendar date. Unlike time.Date, it
+// does not normalize out-of-range values, so day 0, day 32 and month 13 are
+// all rejected.
+func NewDateStrict(year int, month time.Month, day int) (Date, error) {
+	d := Date{Year: year, Month: month, Day: day}
+	if !d.IsValid() {
+		return Date{}, fmt.Errorf("invalid date: year %d, month %d, day %d", year, int(month), day)
+	}
+	return d, nil
+}
+
 // ParseDate parses a string in RFC3339 full-date format and returns the date value it represents.
 func ParseDate(s string) (Date, error) {
+	// Decode the canonical form directly; time.Parse is only needed to
+	// produce its error for anything else.
+	if d, ok := splitDate(s); ok && d.IsValid() {
+		return d, nil
+	}
 	t, err := time.Parse("2006-01-02", s)
 	if err != nil {
 		return Date{}, err
@@ -35,9 +56,92 @@ func ParseDate(s string) (Date, error) {
 	return DateOf(t), nil
 }
 
+// ParseDateStrict is like ParseDate, but a string of the form YYYY-MM-DD
+// that names a day that does not exist, such as "2024-02-30" or
+// "2023-02-29", is reported with an error of the form
+// "invalid date: 2024-02-30". ParseDateStrict never normalizes its input.
+func ParseDateStrict(s string) (Date, error) {
+	if d, ok := splitDate(s); ok && !d.IsValid() {
+		return Date{}, fmt.Errorf("invalid date: %s", s)
+	}
+	return ParseDate(s)
+}
+
+// ParseDateCompact parses a string in the ISO 8601 basic format YYYYMMDD,
+// with no separators, and returns the date value it represents. The string
+// must consist of exactly eight digits.
+func ParseDateCompact(s string) (Date, error) {
+	if _, ok := atoi(s); len(s) != 8 || !ok {
+		return Date{}, fmt.Errorf("invalid compact date %q: want 8 digits", s)
+	}
+	t, err := time.Parse("20060102", s)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+// StringCompact returns the date in the ISO 8601 basic format YYYYMMDD
+// accepted by ParseDateCompact.
+func (d Date) StringCompact() string {
+	return fmt.Sprintf("%04d%02d%02d", d.Year, d.Month, d.Day)
+}
+
+// unixEpoch is the date of the Unix epoch, 1970-01-01.
+var unixEpoch = Date{Year: 1970, Month: time.January, Day: 1}
+
+// DateFromEpochDays returns the date that is n days after 1970-01-01.
+// This is the representation some drivers use for DATE columns scanned
+// as integers, and it is how Date.Scan interprets integer values.
+//
+// Do not confuse it with DateTimeFromUnixMicros: a DATETIME sent as
+// microseconds since the epoch is also an integer, and for instants early in
+// 1970 the two magnitudes overlap. Decode each column with the function that
+// matches its type.
+func DateFromEpochDays(n int64) Date {
+	return unixEpoch.AddDays(int(n))
+}
+
+// DateFromYearMonth returns the first day of the month identified by a
+// month key of the form YYYYMM, such as 202305 for 2023-05-01. A month
+// outside the range 1-12 is normalized as by time.Date.
+func DateFromYearMonth(yyyymm int) Date {
+	return Date{Year: yyyymm / 100, Month: time.Month(yyyymm % 100), Day: 1}.normalize()
+}
+
+// julianDayOfEpoch is the Julian Day Number of 1970-01-01.
+const julianDayOfEpoch = 2440588
+
+// DateFromJulianDay returns the proleptic Gregorian date with the given
+// Julian Day Number, the count of days used in astronomy. For example,
+// JDN 2451545 is 2000-01-01.
+func DateFromJulianDay(jdn int) Date {
+	return DateFromEpochDays(int64(jdn - julianDayOfEpoch))
+}
+
+// JulianDay returns the Julian Day Number of d. It is the inverse of
+// DateFromJulianDay.
+func (d Date) JulianDay() int {
+	return int(d.EpochDays()) + julianDayOfEpoch
+}
+
+// EpochDays returns the number of days between 1970-01-01 and d.
+// It is the inverse of DateFromEpochDays.
+func (d Date) EpochDays() int64 {
+	return int64(d.DaysSince(unixEpoch))
+}
+
 // String returns the date in RFC3339 full-date format.
 func (d Date) String() string {
-	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+	if d.Year < 0 || d.Year > 9999 || d.Month < 0 || d.Month > 99 || d.Day < 0 || d.Day > 99 {
+		return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+	}
+	b := [10]byte{
+		byte('0' + d.Year/1000), byte('0' + d.Year/100%10), byte('0' + d.Year/10%10), byte('0' + d.Year%10),
+		'-', byte('0' + d.Month/10), byte('0' + d.Month%10),
+		'-', byte('0' + d.Day/10), byte('0' + d.Day%10),
+	}
+	return string(b[:])
 }
 
 // IsValid reports whether the date is valid.
@@ -63,6 +167,18 @@ func (d Date) In(loc *time.Location) time.Time {
 	return time.Date(d.Year, time.Month(d.Month), d.Day, 0, 0, 0, 0, loc)
 }
 
+// Quarter returns the quarter of the year in which d falls, from 1
+// (January to March) to 4 (October to December).
+func (d Date) Quarter() int {
+	return (int(d.Month)-1)/3 + 1
+}
+
+// DayOfYear returns the day of the year of d, from 1 to 365 in non-leap
+// years and 1 to 366 in leap years.
+func (d Date) DayOfYear() int {
+	return d.In(time.UTC).YearDay()
+}
+
 // AddDays returns the date that is n days in the future.
 // n can also be negative to go into the past.
 func (d Date) AddDays(n int) Date {
@@ -78,6 +194,26 @@ func (d Date) DaysSince(s Date) (days int) {
 	return int(deltaUnix / 86400)
 }
 
+// Sub returns the duration between midnight UTC on d2 and midnight UTC on
+// d. Because both are taken in UTC, every day is exactly 24 hours long and
+// the result is consistent with DaysSince:
+//
+//	d.Sub(d2) == time.Duration(d.DaysSince(d2)) * 24 * time.Hour
+//
+// The result saturates at the minimum or maximum Duration for dates more
+// than about 292 years apart.
+func (d Date) Sub(d2 Date) time.Duration {
+	return d.In(time.UTC).Sub(d2.In(time.UTC))
+}
+
+// WeeksSince returns the signed number of whole weeks between the date and
+// s, that is d.DaysSince(s) / 7. The result is truncated toward zero, so
+// it is negative when d is at least a full week before s, and 6 days either
+// side of s count as 0 weeks.
+func (d Date) WeeksSince(s Date) int {
+	return d.DaysSince(s) / 7
+}
+
 // Before reports whether d occurs before d2.
 func (d Date) Before(d2 Date) bool {
 	if d.Year != d2.Year {
@@ -105,6 +241,72 @@ func (d Date) Compare(d2 Date) int {
 	return 0
 }
 
+// Between reports whether d lies between start and end. If inclusive is
+// true the range includes both bounds, and otherwise it excludes both. If
+// start is after end, Between returns false.
+func (d Date) Between(start, end Date, inclusive bool) bool {
+	if start.After(end) {
+		return false
+	}
+	if inclusive {
+		return !d.Before(start) && !d.After(end)
+	}
+	return d.After(start) && d.Before(end)
+}
+
+// MinDate returns the earliest of dates, or the zero Date if dates is
+// empty.
+func MinDate(dates ...Date) Date {
+	if len(dates) == 0 {
+		return Date{}
+	}
+	min := dates[0]
+	for _, d := range dates[1:] {
+		if d.Before(min) {
+			min = d
+		}
+	}
+	return min
+}
+
+// MaxDate returns the latest of dates, or the zero Date if dates is empty.
+func MaxDate(dates ...Date) Date {
+	if len(dates) == 0 {
+		return Date{}
+	}
+	max := dates[0]
+	for _, d := range dates[1:] {
+		if d.After(max) {
+			max = d
+		}
+	}
+	return max
+}
+
+// Clamp returns min if d is before min, max if d is after max, and d
+// otherwise. If min is after max the bounds are swapped, so the result
+// always lies within the range they describe.
+func (d Date) Clamp(min, max Date) Date {
+	if min.After(max) {
+		min, max = max, min
+	}
+	if d.Before(min) {
+		return min
+	}
+	if d.After(max) {
+		return max
+	}
+	return d
+}
+
+// CompareDateTime compares d, taken as 00:00:00 on that date, with dt. If
+// that midnight is before dt, it returns -1; if it is after dt, it returns
+// +1; otherwise it returns 0. So a date compares equal only to the DateTime
+// at the very start of the same day, and before every later time that day.
+func (d Date) CompareDateTime(dt DateTime) int {
+	return DateTime{Date: d}.Compare(dt)
+}
+
 // IsZero reports whether date fields are set to their default value.
 func (d Date) IsZero() bool {
 	return (d.Year == 0) && (int(d.Month) == 0) && (d.Day == 0)
@@ -126,34 +328,373 @@ func (d *Date) UnmarshalText(data []byte) error {
 
 // Scan implementa el interface sql.Scanner para Date
 func (d *Date) Scan(value interface{}) error {
-	if value == nil {
+	value, isNil := unwrapScanValue(value)
+	if isNil {
 		*d = Date{}
 		return nil
 	}
 
 	switch v := value.(type) {
+	case *string:
+		return d.Scan(*v)
+	case *time.Time:
+		return d.Scan(*v)
 	case string:
-		parsed, err := ParseDate(v)
+		parsed, err := scanDateString(v)
 		if err != nil {
 			return err
 		}
 		*d = parsed
 	case time.Time:
+		if ZeroTimeAsNull && v.IsZero() {
+			*d = Date{}
+			return nil
+		}
 		*d = DateOf(v)
 	case civil.Date:
-		*d = Date{
-			Year:  v.Year,
-			Month: v.Month,
-			Day:   v.Day,
+		*d = DateFromCivil(v)
+		if NormalizeOnScan && !d.IsValid() {
+			*d = d.normalize()
 		}
+	case int64:
+		return d.scanInt(v)
+	case int:
+		return d.scanInt(int64(v))
+	case int32:
+		return d.scanInt(int64(v))
+	case uint:
+		return d.scanUint(uint64(v))
+	case uint32:
+		return d.scanInt(int64(v))
+	case uint64:
+		return d.scanUint(v)
 	default:
+		if rd, ok := scanRegistered(value); ok {
+			*d = rd
+			return nil
+		}
+		if ok, err := d.scanPGDate(value); ok {
+			return err
+		}
+		if d.scanNullCivilDate(value) {
+			return nil
+		}
+		if ok, err := d.scanProtoDate(value); ok {
+			return err
+		}
 		return fmt.Errorf("no se puede convertir %T a Date", value)
 	}
 	return nil
 }
 
+// unwrapScanValue removes the extra indirection that some drivers and
+// stored-procedure paths add around scanned values: it unwraps sql.Out and
+// dereferences pointers to pointers, such as **time.Time, until at most one
+// level of pointer remains. It reports whether v is nil or a nil pointer was
+// found at any level, in which case the value should scan as the zero value.
+func unwrapScanValue(v any) (any, bool) {
+	switch out := v.(type) {
+	case sql.Out:
+		v = out.Dest
+	case *sql.Out:
+		if out == nil {
+			return nil, true
+		}
+		v = out.Dest
+	}
+	if v == nil {
+		return nil, true
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer {
+		return v, false
+	}
+	for {
+		if rv.IsNil() {
+			return nil, true
+		}
+		if rv.Type().Elem().Kind() != reflect.Pointer {
+			return rv.Interface(), false
+		}
+		rv = rv.Elem()
+	}
+}
+
+// scanPGDate sets d from a value shaped like pgx's pgtype.Date, that is a
+// struct with a time.Time field Time, a bool field Valid and an integer field
+// InfinityModifier. It reports whether value had that shape. Valid=false
+// scans as the zero Date, and a non-zero InfinityModifier is an error since
+// Date cannot represent infinity.
+func (d *Date) scanPGDate(value any) (bool, error) {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return false, nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return false, nil
+	}
+	tf := rv.FieldByName("Time")
+	if !tf.IsValid() || tf.Type() != reflect.TypeOf(time.Time{}) {
+		return false, nil
+	}
+	valid := rv.FieldByName("Valid")
+	inf := rv.FieldByName("InfinityModifier")
+	if !valid.IsValid() || valid.Kind() != reflect.Bool || !inf.IsValid() || !inf.CanInt() {
+		return false, nil
+	}
+	if !valid.Bool() {
+		*d = Date{}
+		return true, nil
+	}
+	if inf.Int() != 0 {
+		return true, fmt.Errorf("cannot scan infinite %T into Date", value)
+	}
+	*d = DateOf(tf.Interface().(time.Time))
+	return true, nil
+}
+
+// A DateIntMode selects how Date.Scan interprets integer values.
+type DateIntMode int
+
+// The integer interpretations supported by Date.Scan.
+const (
+	// IntEpochDays interprets integers as days since 1970-01-01, as decoded
+	// by DateFromEpochDays.
+	IntEpochDays DateIntMode = iota
+	// IntYearMonth interprets six-digit integers of the form YYYYMM as the
+	// first day of that month, as decoded by DateFromYearMonth. Other
+	// integers are rejected.
+	IntYearMonth
+	// IntJulianDay interprets integers as Julian Day Numbers, as decoded
+	// by DateFromJulianDay.
+	IntJulianDay
+)
+
+// DateScanIntMode is the interpretation Date.Scan applies to integer
+// values. It defaults to IntEpochDays.
+var DateScanIntMode = IntEpochDays
+
+// scanNullCivilDate sets d from a value shaped like the BigQuery client's
+// bigquery.NullDate, that is a struct with a civil.Date field Date and a
+// bool field Valid. It reports whether value had that shape. Valid=false
+// scans as the zero Date. Matching on shape avoids importing the BigQuery
+// client.
+func (d *Date) scanNullCivilDate(value any) bool {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return false
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return false
+	}
+	df := rv.FieldByName("Date")
+	valid := rv.FieldByName("Valid")
+	if !df.IsValid() || df.Type() != reflect.TypeOf(civil.Date{}) || !valid.IsValid() || valid.Kind() != reflect.Bool {
+		return false
+	}
+	if !valid.Bool() {
+		*d = Date{}
+		return true
+	}
+	*d = DateFromCivil(df.Interface().(civil.Date))
+	return true
+}
+
+// scanProtoDate sets d from a value shaped like the google.type.Date
+// protobuf message, that is a pointer to a struct with int32 fields Year,
+// Month and Day. It reports whether value had that shape. A nil pointer,
+// as found in unset optional fields, and an all-zero message scan as the
+// zero Date. Partial dates with a zero year, month or day cannot be
+// represented and are an error. Matching on shape avoids importing
+// genproto.
+func (d *Date) scanProtoDate(value any) (bool, error) {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Pointer || rv.Type().Elem().Kind() != reflect.Struct {
+		return false, nil
+	}
+	st := rv.Type().Elem()
+	for _, name := range []string{"Year", "Month", "Day"} {
+		if f, ok := st.FieldByName(name); !ok || f.Type.Kind() != reflect.Int32 {
+			return false, nil
+		}
+	}
+	if rv.IsNil() {
+		*d = Date{}
+		return true, nil
+	}
+	rv = rv.Elem()
+	y, m, dd := int(rv.FieldByName("Year").Int()), int(rv.FieldByName("Month").Int()), int(rv.FieldByName("Day").Int())
+	if y == 0 && m == 0 && dd == 0 {
+		*d = Date{}
+		return true, nil
+	}
+	if y == 0 || m == 0 || dd == 0 {
+		return true, fmt.Errorf("cannot scan partial date %04d-%02d-%02d from %T into Date", y, m, dd, value)
+	}
+	nd, err := NewDateStrict(y, time.Month(m), dd)
+	if err != nil {
+		return true, err
+	}
+	*d = nd
+	return true, nil
+}
+
+// scanInt sets d from an integer according to DateScanIntMode.
+func (d *Date) scanInt(n int64) error {
+	switch DateScanIntMode {
+	case IntYearMonth:
+		if n < 100000 || n > 999999 || n%100 < 1 || n%100 > 12 {
+			return fmt.Errorf("%d is not a YYYYMM month key", n)
+		}
+		*d = DateFromYearMonth(int(n))
+	case IntJulianDay:
+		*d = DateFromJulianDay(int(n))
+	default:
+		*d = DateFromEpochDays(n)
+	}
+	return nil
+}
+
+// scanUint sets d from an unsigned integer according to DateScanIntMode.
+func (d *Date) scanUint(n uint64) error {
+	if n > math.MaxInt64 {
+		return fmt.Errorf("integer %d out of range for Date", n)
+	}
+	return d.scanInt(int64(n))
+}
+
+// NormalizeOnScan controls how Date.Scan handles values whose fields do not
+// form a valid calendar date, such as "2023-05-00" or "2023-04-31". When
+// false (the default) such strings are rejected. When true they are
+// normalized the same way time.Date does, so day 0 becomes the last day of
+// the previous month and day 31 of a 30-day month becomes the first of the
+// next.
+var NormalizeOnScan bool
+
+// ZeroTimeAsNull controls how Date.Scan and DateTime.Scan handle the zero
+// time.Time (0001-01-01T00:00:00 UTC), which some drivers send in place of
+// NULL. When false (the default) it scans as 0001-01-01 or
+// 0001-01-01T00:00:00. When true it scans as the zero Date or DateTime, so
+// IsZero reports the missing value the same way as for a nil value.
+var ZeroTimeAsNull bool
+
+// ScanYearMonth controls whether Date.Scan accepts a bare year and month,
+// as in "2023-05", meaning the first of that month. It is false by default,
+// so a truncated date is reported as an error instead of silently becoming
+// the first of the month. ParseYearMonth accepts the form either way.
+var ScanYearMonth bool
+
+// normalize returns the valid date that time.Date produces for d's fields.
+func (d Date) normalize() Date {
+	return DateOf(d.In(time.UTC))
+}
+
+// scanDateLayouts are the timestamp layouts tried by Date.Scan after
+// ParseDate and ParseDateTime have both failed. The space-separated
+// datetimes written by Python's str(datetime), such as
+// "2023-05-01 00:00:00.000000", are already accepted by ParseDateTime.
+var scanDateLayouts = []string{
+	time.RFC1123,
+	time.RFC1123Z,
+	time.RFC3339Nano,
+	time.RFC850,
+	time.ANSIC,
+}
+
+// scanDateString parses a string scanned from a driver. It tries ParseDate,
+// then ParseDateTime, then the layouts in scanDateLayouts, then
+// ParseDateText, then ParseYearMonth if ScanYearMonth is set, and returns
+// the date part of the first that succeeds. If none match, the error from
+// ParseDate is returned. MySQL zero dates scan as the zero Date.
+func scanDateString(s string) (Date, error) {
+	if isMySQLZero(s) {
+		return Date{}, nil
+	}
+	d, err := ParseDate(s)
+	if err == nil {
+		return d, nil
+	}
+	if dt, err2 := ParseDateTime(s); err2 == nil {
+		return dt.Date, nil
+	}
+	for _, layout := range scanDateLayouts {
+		if t, err2 := time.Parse(layout, s); err2 == nil {
+			return DateOf(t), nil
+		}
+	}
+	if td, err2 := ParseDateText(s); err2 == nil {
+		return td, nil
+	}
+	if ScanYearMonth {
+		if ym, err2 := ParseYearMonth(s); err2 == nil {
+			return ym, nil
+		}
+	}
+	if NormalizeOnScan {
+		if nd, ok := splitDate(s); ok {
+			return nd.normalize(), nil
+		}
+	}
+	return Date{}, err
+}
+
+// isMySQLZero reports whether s is one of the zero dates MySQL returns in
+// non-strict mode to mean "no date". Scan maps them to the zero value.
+func isMySQLZero(s string) bool {
+	return s == "0000-00-00" || s == "0000-00-00 00:00:00"
+}
+
+// splitDate reads the fields of a string of the form YYYY-MM-DD without
+// checking that they form a valid date.
+func splitDate(s string) (Date, bool) {
+	if len(s) != 10 || s[4] != '-' || s[7] != '-' {
+		return Date{}, false
+	}
+	y, ok1 := atoi(s[0:4])
+	m, ok2 := atoi(s[5:7])
+	d, ok3 := atoi(s[8:10])
+	if !ok1 || !ok2 || !ok3 {
+		return Date{}, false
+	}
+	return Date{Year: y, Month: time.Month(m), Day: d}, true
+}
+
+// atoi parses a non-empty string of at most nine ASCII digits. The length
+// limit keeps the result from overflowing on adversarial input.
+func atoi(s string) (int, bool) {
+	if s == "" || len(s) > 9 {
+		return 0, false
+	}
+	n := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		n = n*10 + int(c-'0')
+	}
+	return n, true
+}
+
+// CivilValues selects what the Value methods of Date, Time and DateTime
+// return. When false (the default) they return the string form, which
+// database/sql accepts with any driver. When true they return the
+// equivalent civil.Date, civil.Time or civil.DateTime, which the BigQuery
+// client binds directly as a query parameter; use it only with drivers that
+// accept those types.
+var CivilValues bool
+
 // Value implementa el interface driver.Valuer para Date
 func (d Date) Value() (driver.Value, error) {
+	if CivilValues {
+		return d.Civil(), nil
+	}
 	return d.String(), nil
 }
 
@@ -185,9 +726,15 @@ func TimeOf(t time.Time) Time {
 // the HH:MM:SS part of the string, an optional fractional part may appear,
 // consisting of a decimal point followed by one to nine decimal digits.
 // (RFC3339 admits only one digit after the decimal point).
+//
+// The seconds may also be omitted, as in "13:45" from an HTML time input,
+// in which case they default to zero.
 func ParseTime(s string) (Time, error) {
 	t, err := time.Parse("15:04:05.999999999", s)
 	if err != nil {
+		if t2, err2 := time.Parse("15:04", s); err2 == nil {
+			return TimeOf(t2), nil
+		}
 		return Time{}, err
 	}
 	return TimeOf(t), nil
@@ -204,6 +751,107 @@ func (t Time) String() string {
 	return s + fmt.Sprintf(".%09d", t.Nanosecond)
 }
 
+// ParseTimeCompact parses a string in the ISO 8601 basic format HHMMSS,
+// optionally followed by a decimal point and one to nine fractional digits,
+// and returns the time value it represents. For example, "134500" and
+// "134500.25" are accepted.
+func ParseTimeCompact(s string) (Time, error) {
+	clock, frac, hasFrac := strings.Cut(s, ".")
+	if len(clock) != 6 {
+		return Time{}, fmt.Errorf("invalid compact time %q: want HHMMSS", s)
+	}
+	if _, ok := atoi(clock); !ok {
+		return Time{}, fmt.Errorf("invalid compact time %q: want HHMMSS", s)
+	}
+	var t Time
+	t.Hour, _ = atoi(clock[0:2])
+	t.Minute, _ = atoi(clock[2:4])
+	t.Second, _ = atoi(clock[4:6])
+	if hasFrac {
+		ns, ok := atoi(frac)
+		if !ok || len(frac) > 9 {
+			return Time{}, fmt.Errorf("invalid compact time %q: bad fractional part", s)
+		}
+		for i := len(frac); i < 9; i++ {
+			ns *= 10
+		}
+		t.Nanosecond = ns
+	}
+	if !t.IsValid() {
+		return Time{}, fmt.Errorf("invalid compact time %q: out of range", s)
+	}
+	return t, nil
+}
+
+// StringCompact returns the time in the ISO 8601 basic format accepted by
+// ParseTimeCompact. As with String, a fractional part of nine digits is
+// only generated when Nanosecond is non-zero.
+func (t Time) StringCompact() string {
+	s := fmt.Sprintf("%02d%02d%02d", t.Hour, t.Minute, t.Second)
+	if t.Nanosecond == 0 {
+		return s
+	}
+	return s + fmt.Sprintf(".%09d", t.Nanosecond)
+}
+
+// SortKey returns t as HH:MM:SS.FFFFFFFFF, always with nine fractional
+// digits, so that comparing the keys of two valid Times byte by byte gives
+// the same order as Compare. String does not have this property, since it
+// omits a zero fraction.
+func (t Time) SortKey() string {
+	return t.stringPrecision(9)
+}
+
+// stringPrecision formats t as HH:MM:SS followed by a fractional part of
+// exactly digits digits, truncating the nanoseconds. digits is clamped to
+// the range [0, 9]; zero digits produces no fractional part.
+func (t Time) stringPrecision(digits int) string {
+	s := fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
+	if digits <= 0 {
+		return s
+	}
+	if digits > 9 {
+		digits = 9
+	}
+	frac := fmt.Sprintf("%09d", t.Nanosecond)
+	return s + "." + frac[:digits]
+}
+
+// Add returns the time of day d after t, wrapping around midnight, and the
+// number of whole days carried over, which is negative when going back past
+// midnight. For example, Time{Hour: 23}.Add(2*time.Hour) returns
+// Time{Hour: 1} and 1. Durations longer than a day carry several days.
+func (t Time) Add(d time.Duration) (Time, int) {
+	const day = 24 * time.Hour
+	ns := t.sinceMidnight()
+	days := int(d / day)
+	ns += d % day
+	if ns < 0 {
+		ns += day
+		days--
+	} else if ns >= day {
+		ns -= day
+		days++
+	}
+	res, _ := timeSinceMidnight(int64(ns), Nanoseconds)
+	return res, days
+}
+
+// AddMinutes returns the time of day n minutes after t and the number of
+// days carried over, as Add does. Whole days are split off n before it is
+// converted to a Duration, so any int is accepted without overflow.
+func (t Time) AddMinutes(n int) (Time, int) {
+	const minutesPerDay = 24 * 60
+	res, days := t.Add(time.Duration(n%minutesPerDay) * time.Minute)
+	return res, n/minutesPerDay + days
+}
+
+// sinceMidnight returns the time elapsed between midnight and t.
+func (t Time) sinceMidnight() time.Duration {
+	return time.Duration(t.Hour)*time.Hour + time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second + time.Duration(t.Nanosecond)
+}
+
 // IsValid reports whether the time is valid.
 func (t Time) IsValid() bool {
 	// Construct a non-zero time.
@@ -247,6 +895,19 @@ func (t Time) Compare(t2 Time) int {
 	return 0
 }
 
+// Between reports whether t lies between start and end, as for
+// Date.Between. If start is after end, Between returns false rather than
+// treating the range as wrapping around midnight.
+func (t Time) Between(start, end Time, inclusive bool) bool {
+	if start.After(end) {
+		return false
+	}
+	if inclusive {
+		return !t.Before(start) && !t.After(end)
+	}
+	return t.After(start) && t.Before(end)
+}
+
 // MarshalText implements the encoding.TextMarshaler interface.
 // The output is the result of t.String().
 func (t Time) MarshalText() ([]byte, error) {
@@ -262,52 +923,108 @@ func (t *Time) UnmarshalText(data []byte) error {
 }
 
 // Value implements the database/sql/driver Valuer interface.
+// The result is t.String(), which keeps every non-zero nanosecond, so
+// scanning it back with Scan restores t exactly.
 func (t Time) Value() (driver.Value, error) {
+	if CivilValues {
+		return t.Civil(), nil
+	}
 	return t.String(), nil
 }
 
 // Scan implements the database/sql Scanner interface.
+// A nil value, or a nil pointer at any level, scans as the zero Time.
+// An int64 is a count of TimeScanUnit since midnight, by default
+// microseconds, and a float64 is a number of seconds since midnight, as
+// decoded by TimeFromSeconds. A []byte has surrounding whitespace removed
+// before parsing, since some drivers pad the canonical TIME form.
 func (t *Time) Scan(v any) error {
+	v, isNil := unwrapScanValue(v)
+	if isNil {
+		*t = Time{}
+		return nil
+	}
 	switch vt := v.(type) {
 	case time.Time:
 		*t = TimeOf(vt)
 	case *time.Time:
-		if vt != nil {
-			*t = TimeOf(*vt)
+		*t = TimeOf(*vt)
+	case sql.NullTime:
+		if vt.Valid {
+			*t = TimeOf(vt.Time)
+		} else {
+			*t = Time{}
 		}
 	case string:
 		var err error
-		*t, err = ParseTime(vt)
+		*t, err = scanTimeString(vt)
 		return err
 	case *string:
 		var err error
-		if vt != nil {
-			*t, err = ParseTime(*vt)
-		}
+		*t, err = scanTimeString(*vt)
 		return err
 	case []byte:
 		var err error
-		*t, err = ParseTime(string(vt))
+		*t, err = scanTimeString(string(bytes.TrimSpace(vt)))
 		return err
 	case *[]byte:
 		var err error
-		if vt != nil {
-			*t, err = ParseTime(string(*vt))
-		}
+		*t, err = scanTimeString(string(bytes.TrimSpace(*vt)))
+		return err
+	case int64:
+		var err error
+		*t, err = timeSinceMidnight(vt, TimeScanUnit)
+		return err
+	case float64:
+		var err error
+		*t, err = TimeFromSeconds(vt)
 		return err
 	case civil.Time:
-		*t = Time{
-			Hour:       vt.Hour,
-			Minute:     vt.Minute,
-			Second:     vt.Second,
-			Nanosecond: vt.Nanosecond,
-		}
+		*t = TimeFromCivil(vt)
 	default:
 		return fmt.Errorf("unsupported scan type for Time: %T", v)
 	}
 	return nil
 }
 
+// scanTimeString parses a string scanned from a driver. It tries ParseTime
+// and then ParseTimeTZ. If neither matches, the error from ParseTime is
+// returned.
+func scanTimeString(s string) (Time, error) {
+	t, err := ParseTime(s)
+	if err == nil {
+		return t, nil
+	}
+	if t, err2 := ParseTimeTZ(s); err2 == nil {
+		return t, nil
+	}
+	return Time{}, err
+}
+
+// ParseTimeTZ parses a time of day followed by a UTC offset, such as the
+// PostgreSQL TIMETZ values "13:45:00+02" or "13:45:00.5-03:30", and returns
+// the corresponding time of day in UTC. Since Time has no location, the
+// offset cannot be kept: "13:45:00+02" becomes 11:45:00. The conversion
+// wraps around midnight, so "01:00:00+02" becomes 23:00:00 and the change
+// of day is lost.
+func ParseTimeTZ(s string) (Time, error) {
+	var t time.Time
+	var err error
+	for _, layout := range timeTZLayouts {
+		if t, err = time.Parse(layout, s); err == nil {
+			return TimeOf(t.UTC()), nil
+		}
+	}
+	return Time{}, err
+}
+
+// timeTZLayouts are the offset forms accepted by ParseTimeTZ.
+var timeTZLayouts = []string{
+	"15:04:05.999999999Z07:00",
+	"15:04:05.999999999Z0700",
+	"15:04:05.999999999Z07",
+}
+
 // A DateTime represents a date and time.
 //
 // This type does not include location information, and therefore does not
@@ -327,6 +1044,31 @@ func DateTimeOf(t time.Time) DateTime {
 	}
 }
 
+// CombineDateTime scans dateVal with Date.Scan and timeVal with Time.Scan
+// and returns the DateTime made of the two, for tables that store a
+// datetime in separate DATE and TIME columns.
+func CombineDateTime(dateVal, timeVal any) (DateTime, error) {
+	var dt DateTime
+	if err := dt.Date.Scan(dateVal); err != nil {
+		return DateTime{}, fmt.Errorf("scanning date: %w", err)
+	}
+	if err := dt.Time.Scan(timeVal); err != nil {
+		return DateTime{}, fmt.Errorf("scanning time: %w", err)
+	}
+	return dt, nil
+}
+
+// DateTimeFromUnixMicros returns the DateTime, in UTC, that is n
+// microseconds after 1970-01-01T00:00:00.
+//
+// It must not be used for DATE columns sent as integers; those count days,
+// not microseconds, and are decoded by DateFromEpochDays. For example, the
+// integer 1 is 1970-01-02 as an epoch day but 1970-01-01T00:00:00.000001 as
+// Unix microseconds.
+func DateTimeFromUnixMicros(n int64) DateTime {
+	return DateTimeOf(time.UnixMicro(n).UTC())
+}
+
 // ParseDateTime parses a string and returns the DateTime it represents.
 // ParseDateTime accepts a variant of the RFC3339 date-time format that omits
 // the time offset but includes an optional fractional time, as described in
@@ -334,23 +1076,71 @@ func DateTimeOf(t time.Time) DateTime {
 //
 //	YYYY-MM-DDTHH:MM:SS[.FFFFFFFFF]
 //
-// where the 'T' may be a lower-case 't'.
+// where the 'T' may be a lower-case 't' or a space, as in the output of
+// Python's str(datetime) and many SQL clients.
+//
+// The seconds may also be omitted, as in the values produced by HTML
+// datetime-local inputs, in which case they default to zero. Either
+// separator may be used in that form too:
+//
+//	YYYY-MM-DDTHH:MM
+//	YYYY-MM-DD HH:MM
 func ParseDateTime(s string) (DateTime, error) {
 	t, err := time.Parse("2006-01-02T15:04:05.999999999", s)
 	if err != nil {
-		t, err = time.Parse("2006-01-02t15:04:05.999999999", s)
-		if err != nil {
-			return DateTime{}, err
+		for _, layout := range dateTimeLayouts {
+			if t2, err2 := time.Parse(layout, s); err2 == nil {
+				return DateTimeOf(t2), nil
+			}
 		}
+		return DateTime{}, err
 	}
 	return DateTimeOf(t), nil
 }
 
+// dateTimeLayouts are the alternative layouts accepted by
+// ParseDateTime.
+var dateTimeLayouts = []string{
+	"2006-01-02t15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02t15:04",
+	"2006-01-02 15:04",
+}
+
 // String returns the date in the format described in ParseDate.
 func (dt DateTime) String() string {
 	return dt.Date.String() + "T" + dt.Time.String()
 }
 
+// SortKey returns dt in the format of String but always with nine
+// fractional digits, as described for Time.SortKey. For valid DateTimes in
+// the years 0 to 9999, comparing keys byte by byte gives the same order as
+// Compare.
+func (dt DateTime) SortKey() string {
+	return dt.Date.String() + "T" + dt.Time.SortKey()
+}
+
+// StringPrecision returns the datetime in the format described in
+// ParseDateTime, with the fractional seconds truncated to the given number of
+// digits. digits is clamped to the range [0, 9]. If digits is zero, no
+// fractional part is generated; otherwise the fraction always has exactly
+// digits digits. For example, StringPrecision(6) produces the microsecond
+// form used by BigQuery.
+func (dt DateTime) StringPrecision(digits int) string {
+	return dt.Date.String() + "T" + dt.Time.stringPrecision(digits)
+}
+
+// RFC3339 interprets dt as a wall-clock time in loc and returns the
+// resulting instant in RFC 3339 format with loc's offset at that instant,
+// such as "2023-05-01T13:45:00-05:00". A fractional second is included only
+// if non-zero, without trailing zeros. Times that are skipped or repeated by
+// a daylight saving transition are resolved as described for In. RFC3339
+// panics if loc is nil.
+func (dt DateTime) RFC3339(loc *time.Location) string {
+	return dt.In(loc).Format(time.RFC3339Nano)
+}
+
 // IsValid reports whether the datetime is valid.
 func (dt DateTime) IsValid() bool {
 	return dt.Date.IsValid() && dt.Time.IsValid()
@@ -377,6 +1167,29 @@ func (dt DateTime) In(loc *time.Location) time.Time {
 	return time.Date(dt.Date.Year, dt.Date.Month, dt.Date.Day, dt.Time.Hour, dt.Time.Minute, dt.Time.Second, dt.Time.Nanosecond, loc)
 }
 
+// Add returns the datetime dt+d. The arithmetic is done in UTC, so the
+// result carries correctly across midnight and month and year boundaries,
+// in either direction, and keeps nanosecond precision.
+func (dt DateTime) Add(d time.Duration) DateTime {
+	return DateTimeOf(dt.In(time.UTC).Add(d))
+}
+
+// Sub returns the duration dt-dt2, with both interpreted in UTC. It keeps
+// nanosecond precision and is negative if dt is before dt2. As with
+// time.Time.Sub, the result saturates at the minimum or maximum Duration.
+func (dt DateTime) Sub(dt2 DateTime) time.Duration {
+	return dt.In(time.UTC).Sub(dt2.In(time.UTC))
+}
+
+// Midpoint returns the DateTime halfway between dt and dt2, computed as
+// dt.Add(dt2.Sub(dt)/2) with both interpreted in UTC. An odd number of
+// nanoseconds between them is rounded toward dt. Because Sub saturates,
+// the result is only exact when dt and dt2 are less than about 292 years
+// apart.
+func (dt DateTime) Midpoint(dt2 DateTime) DateTime {
+	return dt.Add(dt2.Sub(dt) / 2)
+}
+
 // Before reports whether dt occurs before dt2.
 func (dt DateTime) Before(dt2 DateTime) bool {
 	return dt.In(time.UTC).Before(dt2.In(time.UTC))
@@ -393,6 +1206,103 @@ func (dt DateTime) Compare(dt2 DateTime) int {
 	return dt.In(time.UTC).Compare(dt2.In(time.UTC))
 }
 
+// Between reports whether dt lies between start and end, as for
+// Date.Between. If start is after end, Between returns false.
+func (dt DateTime) Between(start, end DateTime, inclusive bool) bool {
+	if start.After(end) {
+		return false
+	}
+	if inclusive {
+		return !dt.Before(start) && !dt.After(end)
+	}
+	return dt.After(start) && dt.Before(end)
+}
+
+// MinDateTime returns the earliest of dts, or the zero DateTime if dts is
+// empty.
+func MinDateTime(dts ...DateTime) DateTime {
+	if len(dts) == 0 {
+		return DateTime{}
+	}
+	min := dts[0]
+	for _, dt := range dts[1:] {
+		if dt.Before(min) {
+			min = dt
+		}
+	}
+	return min
+}
+
+// MaxDateTime returns the latest of dts, or the zero DateTime if dts is
+// empty.
+func MaxDateTime(dts ...DateTime) DateTime {
+	if len(dts) == 0 {
+		return DateTime{}
+	}
+	max := dts[0]
+	for _, dt := range dts[1:] {
+		if dt.After(max) {
+			max = dt
+		}
+	}
+	return max
+}
+
+// Clamp returns min if dt is before min, max if dt is after max, and dt
+// otherwise. If min is after max the bounds are swapped, so the result
+// always lies within the range they describe.
+func (dt DateTime) Clamp(min, max DateTime) DateTime {
+	if min.After(max) {
+		min, max = max, min
+	}
+	if dt.Before(min) {
+		return min
+	}
+	if dt.After(max) {
+		return max
+	}
+	return dt
+}
+
+// TimeSlot returns the index of the slot of length slot, counted from
+// midnight, that contains dt's time of day. For example, a slot of one hour
+// gives 0 to 23 and a slot of 15 minutes gives 0 to 95. If slot does not
+// divide 24 hours evenly, the last slot of the day is shorter than the
+// others; with 7-hour slots the indexes are 0 to 3, slot 3 covering
+// 21:00 to midnight. TimeSlot panics if slot is not positive.
+func (dt DateTime) TimeSlot(slot time.Duration) int {
+	if slot <= 0 {
+		panic("bigqueryGoDate: non-positive time slot")
+	}
+	return int(dt.Time.sinceMidnight() / slot)
+}
+
+// Key returns an int64 that orders DateTimes chronologically to the
+// microsecond, the precision of BigQuery's DATETIME type: it is the number
+// of microseconds between 1970-01-01T00:00:00 and dt, with any finer
+// fraction truncated. For DateTimes with microsecond precision, such as
+// every value read from BigQuery, a.Key() < b.Key() if and only if
+// a.Before(b); values less than a microsecond apart may share a key. The
+// ordering holds for all valid DateTimes in the years 0 to 9999, whose
+// nanosecond counts would not fit in an int64. Key does not allocate.
+func (dt DateTime) Key() int64 {
+	const microsPerDay = 24 * 60 * 60 * 1e6
+	return dt.Date.EpochDays()*microsPerDay + int64(dt.Time.sinceMidnight()/time.Microsecond)
+}
+
+// EqualWithin reports whether dt and dt2 are at most tol apart, in either
+// direction. A negative tol never matches.
+func (dt DateTime) EqualWithin(dt2 DateTime, tol time.Duration) bool {
+	d := dt.Sub(dt2)
+	return d >= -tol && d <= tol
+}
+
+// EqualToMinute reports whether dt and dt2 have the same date, hour and
+// minute, ignoring seconds and nanoseconds.
+func (dt DateTime) EqualToMinute(dt2 DateTime) bool {
+	return dt.Date == dt2.Date && dt.Time.Hour == dt2.Time.Hour && dt.Time.Minute == dt2.Time.Minute
+}
+
 // IsZero reports whether datetime fields are set to their default value.
 func (dt DateTime) IsZero() bool {
 	return dt.Date.IsZero() && dt.Time.IsZero()
@@ -413,57 +1323,107 @@ func (dt *DateTime) UnmarshalText(data []byte) error {
 }
 
 // Value implements the database/sql/driver Valuer interface.
+// As with Time.Value, the result round-trips through Scan without loss of
+// precision.
 func (dt DateTime) Value() (driver.Value, error) {
+	if CivilValues {
+		return dt.Civil(), nil
+	}
 	return dt.String(), nil
 }
 
 // Scan implements the database/sql Scanner interface.
+// A nil value, or a nil pointer at any level, scans as the zero DateTime.
+// A value with an AsTime() time.Time method, such as a protobuf
+// *timestamppb.Timestamp, is converted to its DateTime in UTC, and an
+// int64 is a count of DateTimeScanUnit since DateTimeScanEpoch, by default
+// microseconds since 1970-01-01T00:00:00.
 func (dt *DateTime) Scan(v any) error {
+	v, isNil := unwrapScanValue(v)
+	if isNil {
+		*dt = DateTime{}
+		return nil
+	}
 	switch vt := v.(type) {
 	case time.Time:
-		*dt = DateTimeOf(vt)
+		*dt = dateTimeOfScanned(vt)
 	case *time.Time:
-		if vt != nil {
-			*dt = DateTimeOf(*vt)
+		*dt = dateTimeOfScanned(*vt)
+	case sql.NullTime:
+		if vt.Valid {
+			*dt = dateTimeOfScanned(vt.Time)
+		} else {
+			*dt = DateTime{}
 		}
+	case int64:
+		var err error
+		*dt, err = dateTimeSinceEpoch(vt, DateTimeScanUnit, DateTimeScanEpoch)
+		return err
+	case interface{ AsTime() time.Time }:
+		// DateTime has no time zone, so the instant is expressed in UTC.
+		*dt = DateTimeOf(vt.AsTime().UTC())
 	case civil.DateTime:
-		*dt = DateTime{
-			Date: Date{
-				Year:  vt.Date.Year,
-				Month: vt.Date.Month,
-				Day:   vt.Date.Day,
-			},
-			Time: Time{
-				Hour:       vt.Time.Hour,
-				Minute:     vt.Time.Minute,
-				Second:     vt.Time.Second,
-				Nanosecond: vt.Time.Nanosecond,
-			},
-		}
+		*dt = DateTimeFromCivil(vt)
 	case string:
 		var err error
-		*dt, err = ParseDateTime(vt)
+		*dt, err = scanDateTimeString(vt)
 		return err
 	case *string:
 		var err error
-		if vt != nil {
-			*dt, err = ParseDateTime(*vt)
-		}
+		*dt, err = scanDateTimeString(*vt)
 		return err
 	case []byte:
 		var err error
-		*dt, err = ParseDateTime(string(vt))
+		*dt, err = scanDateTimeString(string(vt))
 		return err
 	case *[]byte:
 		var err error
-		if vt != nil {
-			*dt, err = ParseDateTime(string(*vt))
-		}
+		*dt, err = scanDateTimeString(string(*vt))
 		return err
 	default:
-		fmt.Printf("Type: %v\n", reflect.TypeOf(v))
-		fmt.Printf("Value: %v\n", v)
 		return fmt.Errorf("unsupported scan type for DateTime: %T", v)
 	}
 	return nil
 }
+
+// dateTimeOfScanned returns DateTimeOf(t), or the zero DateTime if t is the
+// zero time.Time and ZeroTimeAsNull is set.
+func dateTimeOfScanned(t time.Time) DateTime {
+	if ZeroTimeAsNull && t.IsZero() {
+		return DateTime{}
+	}
+	return DateTimeOf(t)
+}
+
+// scanDateTimeString parses a string scanned from a driver. It tries
+// ParseDateTime and then ParseDateTimeSnowflake. If neither matches, the
+// error from ParseDateTime is returned. MySQL zero dates scan as the zero
+// DateTime.
+func scanDateTimeString(s string) (DateTime, error) {
+	if isMySQLZero(s) {
+		return DateTime{}, nil
+	}
+	dt, err := ParseDateTime(s)
+	if err == nil {
+		return dt, nil
+	}
+	if dt, err2 := ParseDateTimeSnowflake(s); err2 == nil {
+		return dt, nil
+	}
+	return DateTime{}, err
+}
+
+// ParseDateTimeSnowflake parses the timestamp form exported by Snowflake,
+//
+//	YYYY-MM-DD HH:MM:SS[.FFFFFFFFF] +HHMM
+//
+// with a space before the UTC offset, such as
+// "2023-05-01 13:45:00.123 -0700". The instant is converted to UTC and the
+// offset discarded, so that example yields 2023-05-01T20:45:00.123.
+func ParseDateTimeSnowflake(s string) (DateTime, error) {
+	t, err := time.Parse("2006-01-02 15:04:05.999999999 -0700", s)
+	if err != nil {
+		return DateTime{}, err
+	}
+	return DateTimeOf(t.UTC()), nil
+}
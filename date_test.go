@@ -0,0 +1,1543 @@
+package bigqueryGoDate
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+)
+
+func TestDateScanTimestampLayouts(t *testing.T) {
+	want := Date{2023, 5, 1}
+	for _, s := range []string{
+		"2023-05-01",
+		// Datetimes.
+		"2023-05-01T13:45:00",
+		"2023-05-01T13:45:00.5",
+		// Timestamp layouts some drivers stringify to.
+		"Mon, 01 May 2023 13:45:00 UTC",
+		"Mon, 01 May 2023 13:45:00 -0700",
+		"2023-05-01T13:45:00.5+02:00",
+		"Monday, 01-May-23 13:45:00 UTC",
+		"Mon May  1 13:45:00 2023",
+	} {
+		var d Date
+		if err := d.Scan(s); err != nil || d != want {
+			t.Errorf("Scan(%q) = %v, %v; want %v", s, d, err, want)
+		}
+	}
+
+	for _, s := range []string{"", "2023-02-30", "01/05/2023", "not a date", "Mon, 30 Feb 2023 13:45:00 UTC"} {
+		var d Date
+		err := d.Scan(s)
+		if err == nil {
+			t.Errorf("Scan(%q) = %v, want an error", s, d)
+			continue
+		}
+		// The error is ParseDate's.
+		if _, want := ParseDate(s); fmt.Sprint(err) != fmt.Sprint(want) {
+			t.Errorf("Scan(%q) error = %v, want %v", s, err, want)
+		}
+	}
+}
+
+func TestStringPrecision(t *testing.T) {
+	dt := DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 123456789}}
+	for _, tc := range []struct {
+		digits int
+		want   string
+	}{
+		{-1, "2023-05-01T13:45:00"},
+		{0, "2023-05-01T13:45:00"},
+		{1, "2023-05-01T13:45:00.1"},
+		{3, "2023-05-01T13:45:00.123"},
+		{6, "2023-05-01T13:45:00.123456"},
+		{9, "2023-05-01T13:45:00.123456789"},
+		{12, "2023-05-01T13:45:00.123456789"},
+	} {
+		if got := dt.StringPrecision(tc.digits); got != tc.want {
+			t.Errorf("StringPrecision(%d) = %q, want %q", tc.digits, got, tc.want)
+		}
+	}
+	// The fraction is truncated, not rounded, and kept when zero.
+	for _, tc := range []struct {
+		t      Time
+		digits int
+		want   string
+	}{
+		{Time{23, 59, 59, 999999999}, 3, "2023-05-01T23:59:59.999"},
+		{Time{Hour: 13}, 6, "2023-05-01T13:00:00.000000"},
+	} {
+		dt := DateTime{Date{2023, 5, 1}, tc.t}
+		if got := dt.StringPrecision(tc.digits); got != tc.want {
+			t.Errorf("%v.StringPrecision(%d) = %q, want %q", dt, tc.digits, got, tc.want)
+		}
+	}
+}
+
+func TestNormalizeOnScan(t *testing.T) {
+	defer func(b bool) { NormalizeOnScan = b }(NormalizeOnScan)
+	for _, tc := range []struct {
+		v    any
+		want Date
+	}{
+		{"2023-05-00", Date{2023, 4, 30}},
+		{"2023-04-31", Date{2023, 5, 1}},
+		{"2023-02-29", Date{2023, 3, 1}},
+		{"2023-12-32", Date{2024, 1, 1}},
+		{"2023-13-01", Date{2024, 1, 1}},
+		{civil.Date{Year: 2024, Month: 3, Day: 0}, Date{2024, 2, 29}},
+	} {
+		NormalizeOnScan = false
+		var d Date
+		if _, isCivil := tc.v.(civil.Date); !isCivil {
+			if err := d.Scan(tc.v); err == nil {
+				t.Errorf("Scan(%v) without NormalizeOnScan = %v, want an error", tc.v, d)
+			}
+		}
+		NormalizeOnScan = true
+		if err := d.Scan(tc.v); err != nil || d != tc.want {
+			t.Errorf("Scan(%v) with NormalizeOnScan = %v, %v; want %v", tc.v, d, err, tc.want)
+		}
+	}
+	// Malformed strings are still errors.
+	var d Date
+	if err := d.Scan("2023-5-1"); err == nil {
+		t.Errorf(`Scan("2023-5-1") with NormalizeOnScan = %v, want an error`, d)
+	}
+}
+
+func TestDateScanUnsigned(t *testing.T) {
+	want := Date{2023, 5, 1}
+	for _, v := range []any{uint(19478), uint32(19478), uint64(19478), int(19478), int32(19478), int64(19478)} {
+		var d Date
+		if err := d.Scan(v); err != nil || d != want {
+			t.Errorf("Scan(%T(%v)) = %v, %v; want %v", v, v, d, err, want)
+		}
+	}
+	var d Date
+	if err := d.Scan(int64(-1)); err != nil || d != (Date{1969, 12, 31}) {
+		t.Errorf("Scan(int64(-1)) = %v, %v; want 1969-12-31", d, err)
+	}
+	// Too large for an int64, so not a day count at all.
+	if err := d.Scan(uint64(math.MaxUint64)); err == nil {
+		t.Errorf("Scan(MaxUint64) = %v, want an error", d)
+	}
+}
+
+func TestEqualToMinute(t *testing.T) {
+	base := DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 0}}
+	for _, tc := range []struct {
+		other DateTime
+		want  bool
+	}{
+		{base, true},
+		{DateTime{Date{2023, 5, 1}, Time{13, 45, 59, 999999999}}, true},
+		{DateTime{Date{2023, 5, 1}, Time{13, 46, 0, 0}}, false},
+		{DateTime{Date{2023, 5, 1}, Time{13, 44, 59, 999999999}}, false},
+		{DateTime{Date{2023, 5, 1}, Time{14, 45, 0, 0}}, false},
+		{DateTime{Date{2023, 5, 2}, Time{13, 45, 0, 0}}, false},
+	} {
+		if got := base.EqualToMinute(tc.other); got != tc.want {
+			t.Errorf("%v.EqualToMinute(%v) = %v, want %v", base, tc.other, got, tc.want)
+		}
+	}
+}
+
+func TestEpochDaysAndUnixMicros(t *testing.T) {
+	// The same small integer means different things as a DATE and as a
+	// DATETIME.
+	for _, tc := range []struct {
+		n      int64
+		date   Date
+		micros DateTime
+	}{
+		{0, Date{1970, 1, 1}, DateTime{Date{1970, 1, 1}, Time{}}},
+		{1, Date{1970, 1, 2}, DateTime{Date{1970, 1, 1}, Time{0, 0, 0, 1000}}},
+		{-1, Date{1969, 12, 31}, DateTime{Date{1969, 12, 31}, Time{23, 59, 59, 999999000}}},
+		{19478, Date{2023, 5, 1}, DateTime{Date{1970, 1, 1}, Time{0, 0, 0, 19478000}}},
+	} {
+		if got := DateFromEpochDays(tc.n); got != tc.date {
+			t.Errorf("DateFromEpochDays(%d) = %v, want %v", tc.n, got, tc.date)
+		}
+		if got := tc.date.EpochDays(); got != tc.n {
+			t.Errorf("%v.EpochDays() = %d, want %d", tc.date, got, tc.n)
+		}
+		if got := DateTimeFromUnixMicros(tc.n); got != tc.micros {
+			t.Errorf("DateTimeFromUnixMicros(%d) = %v, want %v", tc.n, got, tc.micros)
+		}
+	}
+}
+
+func TestScanNullTime(t *testing.T) {
+	tm := time.Date(2023, 5, 1, 13, 45, 0, 123000000, time.UTC)
+	for _, tc := range []struct {
+		name string
+		v    sql.NullTime
+		t    Time
+		dt   DateTime
+	}{
+		{"valid", sql.NullTime{Time: tm, Valid: true}, Time{13, 45, 0, 123000000}, DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 123000000}}},
+		{"invalid", sql.NullTime{Time: tm}, Time{}, DateTime{}},
+	} {
+		gotTime := Time{1, 2, 3, 4}
+		if err := gotTime.Scan(tc.v); err != nil || gotTime != tc.t {
+			t.Errorf("%s: Time.Scan = %v, %v; want %v", tc.name, gotTime, err, tc.t)
+		}
+		gotDateTime := DateTime{Date{1999, 1, 1}, Time{1, 2, 3, 4}}
+		if err := gotDateTime.Scan(tc.v); err != nil || gotDateTime != tc.dt {
+			t.Errorf("%s: DateTime.Scan = %v, %v; want %v", tc.name, gotDateTime, err, tc.dt)
+		}
+	}
+}
+
+func TestDateCompact(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		want Date
+		ok   bool
+	}{
+		{"20230501", Date{2023, 5, 1}, true},
+		{"00010101", Date{1, 1, 1}, true},
+		{"20240229", Date{2024, 2, 29}, true},
+		{"20230229", Date{}, false},
+		{"2023051", Date{}, false},
+		{"202305011", Date{}, false},
+		{"2023-5-1", Date{}, false},
+		{"+2023051", Date{}, false},
+		{"", Date{}, false},
+	} {
+		got, err := ParseDateCompact(tc.s)
+		if (err == nil) != tc.ok || got != tc.want {
+			t.Errorf("ParseDateCompact(%q) = %v, %v; want %v, ok=%v", tc.s, got, err, tc.want, tc.ok)
+		}
+		if tc.ok && got.StringCompact() != tc.s {
+			t.Errorf("%v.StringCompact() = %q, want %q", got, got.StringCompact(), tc.s)
+		}
+	}
+}
+
+func TestTimeCompact(t *testing.T) {
+	for _, tc := range []struct {
+		s      string
+		want   Time
+		ok     bool
+		format string // StringCompact of want, if different from s
+	}{
+		{"134500", Time{13, 45, 0, 0}, true, ""},
+		{"000000", Time{}, true, ""},
+		{"235959.999999999", Time{23, 59, 59, 999999999}, true, ""},
+		{"134500.25", Time{13, 45, 0, 250000000}, true, "134500.250000000"},
+		{"134500.000001", Time{13, 45, 0, 1000}, true, "134500.000001000"},
+		{"240000", Time{}, false, ""},
+		{"136000", Time{}, false, ""},
+		{"134560", Time{}, false, ""},
+		{"1345", Time{}, false, ""},
+		{"13:45:00", Time{}, false, ""},
+		{"134500.", Time{}, false, ""},
+		{"134500.1234567890", Time{}, false, ""},
+		{"134500.5a", Time{}, false, ""},
+	} {
+		got, err := ParseTimeCompact(tc.s)
+		if (err == nil) != tc.ok || got != tc.want {
+			t.Errorf("ParseTimeCompact(%q) = %v, %v; want %v, ok=%v", tc.s, got, err, tc.want, tc.ok)
+		}
+		if !tc.ok {
+			continue
+		}
+		want := tc.s
+		if tc.format != "" {
+			want = tc.format
+		}
+		if s := got.StringCompact(); s != want {
+			t.Errorf("%v.StringCompact() = %q, want %q", got, s, want)
+		}
+	}
+}
+
+// pgDate has the shape of pgx's pgtype.Date.
+type pgDate struct {
+	Time             time.Time
+	InfinityModifier int8
+	Valid            bool
+}
+
+func TestDateScanPgDate(t *testing.T) {
+	want := Date{2023, 5, 1}
+	for _, tc := range []struct {
+		name string
+		v    any
+		want Date
+		ok   bool
+	}{
+		{"pgtype.Date", pgDate{Time: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), Valid: true}, want, true},
+		{"*pgtype.Date", &pgDate{Time: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), Valid: true}, want, true},
+		{"invalid pgtype.Date", pgDate{Time: time.Now(), Valid: false}, Date{}, true},
+		{"infinite pgtype.Date", pgDate{Valid: true, InfinityModifier: 1}, Date{}, false},
+		{"-infinite pgtype.Date", pgDate{Valid: true, InfinityModifier: -1}, Date{}, false},
+		{"other struct", struct{ Year int }{2023}, Date{}, false},
+	} {
+		d := Date{1999, 1, 1}
+		err := d.Scan(tc.v)
+		if (err == nil) != tc.ok || (tc.ok && d != tc.want) {
+			t.Errorf("%s: Scan = %v, %v; want %v, ok=%v", tc.name, d, err, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestDateTimeKey(t *testing.T) {
+	// Sorted chronologically, including the far ends of BigQuery's range
+	// and values outside the range of time.Time.UnixNano.
+	sorted := []DateTime{
+		{Date{1, 1, 1}, Time{}},
+		{Date{1, 1, 1}, Time{0, 0, 0, 1000}},
+		{Date{1677, 12, 31}, Time{23, 59, 59, 999999000}},
+		{Date{1969, 12, 31}, Time{23, 59, 59, 999999000}},
+		{Date{1970, 1, 1}, Time{}},
+		{Date{1970, 1, 1}, Time{0, 0, 0, 1000}},
+		{Date{2024, 1, 1}, Time{}},
+		{Date{2262, 4, 12}, Time{}},
+		{Date{9999, 12, 31}, Time{}},
+		{Date{9999, 12, 31}, Time{23, 59, 59, 999999000}},
+	}
+	for i := range sorted {
+		for j := range sorted {
+			a, b := sorted[i], sorted[j]
+			if got, want := a.Key() < b.Key(), a.Before(b); got != want {
+				t.Errorf("%v.Key() < %v.Key() = %v, want %v", a, b, got, want)
+			}
+		}
+	}
+	if got := (DateTime{Date: unixEpoch}).Key(); got != 0 {
+		t.Errorf("Key of the Unix epoch = %d, want 0", got)
+	}
+	dt := DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 123456789}}
+	if got, want := dt.Key(), dt.In(time.UTC).UnixMicro(); got != want {
+		t.Errorf("%v.Key() = %d, want %d", dt, got, want)
+	}
+	if n := testing.AllocsPerRun(100, func() { _ = dt.Key() }); n != 0 {
+		t.Errorf("Key allocates %v times, want 0", n)
+	}
+}
+
+func TestDateScanPythonDateTime(t *testing.T) {
+	want := Date{2023, 5, 1}
+	for _, s := range []string{
+		"2023-05-01 00:00:00.000000", // Python's str(datetime)
+		"2023-05-01 13:45:00.123456",
+		"2023-05-01T00:00:00.000000",
+	} {
+		var d Date
+		if err := d.Scan(s); err != nil || d != want {
+			t.Errorf("Scan(%q) = %v, %v; want %v", s, d, err, want)
+		}
+	}
+	for _, s := range []string{"2023-02-30 00:00:00.000000", "2023-05-01 24:00:00", "2023-05-01X00:00:00"} {
+		var d Date
+		if err := d.Scan(s); err == nil {
+			t.Errorf("Scan(%q) = %v, want an error", s, d)
+		}
+	}
+}
+
+func TestNewDateStrict(t *testing.T) {
+	for _, tc := range []struct {
+		y, m, d int
+		ok      bool
+	}{
+		{2023, 5, 1, true},
+		{2024, 2, 29, true},
+		{2000, 2, 29, true},
+		{2023, 2, 29, false},
+		{1900, 2, 29, false},
+		{2024, 2, 30, false},
+		{2023, 4, 31, false},
+		{2023, 5, 0, false},
+		{2023, 5, 32, false},
+		{2023, 0, 1, false},
+		{2023, 13, 1, false},
+	} {
+		got, err := NewDateStrict(tc.y, time.Month(tc.m), tc.d)
+		want := Date{tc.y, time.Month(tc.m), tc.d}
+		if !tc.ok {
+			want = Date{}
+		}
+		if (err == nil) != tc.ok || got != want {
+			t.Errorf("NewDateStrict(%d, %d, %d) = %v, %v; want %v, ok=%v", tc.y, tc.m, tc.d, got, err, want, tc.ok)
+		}
+	}
+}
+
+func TestValueScanRoundTrip(t *testing.T) {
+	defer func(c bool) { CivilValues = c }(CivilValues)
+	for _, tc := range []struct {
+		precision string
+		ns        int
+	}{
+		{"1ns", 123456789},
+		{"1µs", 123456000},
+		{"1ms", 123000000},
+		{"1s", 0},
+		{"trailing zero digit", 100000000},
+		{"smallest fraction", 1},
+	} {
+		for _, civilValues := range []bool{false, true} {
+			CivilValues = civilValues
+			name := fmt.Sprintf("%s/CivilValues=%v", tc.precision, civilValues)
+
+			tm := Time{23, 59, 59, tc.ns}
+			v, err := tm.Value()
+			if err != nil {
+				t.Fatalf("%s: Time.Value: %v", name, err)
+			}
+			var gotTime Time
+			if err := gotTime.Scan(v); err != nil || gotTime != tm {
+				t.Errorf("%s: Time %v through Value (%v) and Scan = %v, %v", name, tm, v, gotTime, err)
+			}
+
+			dt := DateTime{Date{2023, 5, 1}, tm}
+			v, err = dt.Value()
+			if err != nil {
+				t.Fatalf("%s: DateTime.Value: %v", name, err)
+			}
+			var gotDateTime DateTime
+			if err := gotDateTime.Scan(v); err != nil || gotDateTime != dt {
+				t.Errorf("%s: DateTime %v through Value (%v) and Scan = %v, %v", name, dt, v, gotDateTime, err)
+			}
+		}
+	}
+}
+
+func TestCivilValues(t *testing.T) {
+	defer func(c bool) { CivilValues = c }(CivilValues)
+	d := Date{2023, 5, 1}
+	tm := Time{13, 45, 0, 5}
+	dt := DateTime{d, tm}
+	for _, tc := range []struct {
+		civilValues bool
+		v           interface{ Value() (driver.Value, error) }
+		want        driver.Value
+	}{
+		{false, d, "2023-05-01"},
+		{false, tm, "13:45:00.000000005"},
+		{false, dt, "2023-05-01T13:45:00.000000005"},
+		{true, d, civil.Date{Year: 2023, Month: 5, Day: 1}},
+		{true, tm, civil.Time{Hour: 13, Minute: 45, Nanosecond: 5}},
+		{true, dt, civil.DateTime{Date: civil.Date{Year: 2023, Month: 5, Day: 1}, Time: civil.Time{Hour: 13, Minute: 45, Nanosecond: 5}}},
+	} {
+		CivilValues = tc.civilValues
+		if got, err := tc.v.Value(); err != nil || got != tc.want {
+			t.Errorf("CivilValues=%v: %v.Value() = %#v, %v; want %#v", tc.civilValues, tc.v, got, err, tc.want)
+		}
+	}
+}
+
+func TestDateFromYearMonth(t *testing.T) {
+	for _, tc := range []struct {
+		n    int
+		want Date
+	}{
+		{202305, Date{2023, 5, 1}},
+		{202312, Date{2023, 12, 1}},
+		{101, Date{1, 1, 1}},
+	} {
+		if got := DateFromYearMonth(tc.n); got != tc.want {
+			t.Errorf("DateFromYearMonth(%d) = %v, want %v", tc.n, got, tc.want)
+		}
+	}
+}
+
+func TestDateScanYearMonthInt(t *testing.T) {
+	defer func(m DateIntMode) { DateScanIntMode = m }(DateScanIntMode)
+	DateScanIntMode = IntYearMonth
+	want := Date{2023, 5, 1}
+	for _, v := range []any{int64(202305), int(202305), int32(202305), uint(202305), uint32(202305), uint64(202305)} {
+		var d Date
+		if err := d.Scan(v); err != nil || d != want {
+			t.Errorf("Scan(%T(%v)) = %v, %v; want %v", v, v, d, err, want)
+		}
+	}
+	var d Date
+	for _, n := range []int64{202300, 202313, 20235, 2023050, -202305} {
+		if err := d.Scan(n); err == nil {
+			t.Errorf("IntYearMonth: Scan(%d) = %v, want an error", n, d)
+		}
+	}
+
+	// The default mode still reads day counts.
+	DateScanIntMode = IntEpochDays
+	if err := d.Scan(int64(19478)); err != nil || d != want {
+		t.Errorf("IntEpochDays: Scan(19478) = %v, %v; want %v", d, err, want)
+	}
+}
+
+func TestParseDateTime(t *testing.T) {
+	want := DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 0}}
+	wantFrac := DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 500000000}}
+	for _, tc := range []struct {
+		in   string
+		want DateTime
+		ok   bool
+	}{
+		{"2023-05-01T13:45:00", want, true},
+		{"2023-05-01t13:45:00", want, true},
+		{"2023-05-01 13:45:00", want, true},
+		{"2023-05-01T13:45:00.5", wantFrac, true},
+		{"2023-05-01 13:45:00.500000", wantFrac, true},
+		// Seconds may be left out, as by HTML datetime-local inputs.
+		{"2023-05-01T13:45", want, true},
+		{"2023-05-01t13:45", want, true},
+		{"2023-05-01 13:45", want, true},
+		{"2023-05-01X13:45:00", DateTime{}, false},
+		{"2023-02-30 13:45:00", DateTime{}, false},
+		{"2023-05-01 24:00:00", DateTime{}, false},
+		{"2023-05-01", DateTime{}, false},
+	} {
+		got, err := ParseDateTime(tc.in)
+		if (err == nil) != tc.ok || got != tc.want {
+			t.Errorf("ParseDateTime(%q) = %v, %v; want %v, ok=%v", tc.in, got, err, tc.want, tc.ok)
+		}
+		var scanned DateTime
+		err = scanned.Scan(tc.in)
+		if (err == nil) != tc.ok || scanned != tc.want {
+			t.Errorf("DateTime.Scan(%q) = %v, %v; want %v, ok=%v", tc.in, scanned, err, tc.want, tc.ok)
+		}
+	}
+}
+
+// nullDate has the shape of the BigQuery client's bigquery.NullDate.
+type nullDate struct {
+	Date  civil.Date
+	Valid bool
+}
+
+func TestDateScanNullDate(t *testing.T) {
+	c := civil.Date{Year: 2023, Month: 5, Day: 1}
+	for _, tc := range []struct {
+		name string
+		v    any
+		want Date
+	}{
+		{"NullDate", nullDate{Date: c, Valid: true}, Date{2023, 5, 1}},
+		{"*NullDate", &nullDate{Date: c, Valid: true}, Date{2023, 5, 1}},
+		{"invalid NullDate", nullDate{Date: c}, Date{}},
+		{"invalid *NullDate", &nullDate{Date: c}, Date{}},
+	} {
+		d := Date{1999, 1, 1}
+		if err := d.Scan(tc.v); err != nil || d != tc.want {
+			t.Errorf("%s: Scan = %v, %v; want %v", tc.name, d, err, tc.want)
+		}
+	}
+}
+
+// protoTimestamp has the AsTime method of protobuf's timestamppb.Timestamp.
+type protoTimestamp struct{ t time.Time }
+
+func (ts *protoTimestamp) AsTime() time.Time { return ts.t }
+
+func TestDateTimeScanTimestamp(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		v    *protoTimestamp
+		want DateTime
+	}{
+		// Timestamps are taken in UTC.
+		{"UTC", &protoTimestamp{time.Date(2023, 5, 1, 13, 45, 0, 123000000, time.UTC)}, DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 123000000}}},
+		{"offset", &protoTimestamp{time.Date(2023, 5, 1, 15, 45, 0, 123000000, time.FixedZone("", 2*60*60))}, DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 123000000}}},
+		{"day change", &protoTimestamp{time.Date(2023, 5, 1, 1, 0, 0, 0, time.FixedZone("", 2*60*60))}, DateTime{Date{2023, 4, 30}, Time{Hour: 23}}},
+		{"epoch", &protoTimestamp{time.Unix(0, 0)}, DateTime{Date: unixEpoch}},
+	} {
+		got := DateTime{Date{1999, 1, 1}, Time{1, 2, 3, 4}}
+		if err := got.Scan(tc.v); err != nil || got != tc.want {
+			t.Errorf("%s: Scan(%v) = %v, %v; want %v", tc.name, tc.v.t, got, err, tc.want)
+		}
+	}
+}
+
+func TestTimeScanFloat(t *testing.T) {
+	for _, tc := range []struct {
+		v    float64
+		want Time
+	}{
+		{49500.5, Time{13, 45, 0, 500000000}},
+		{0, Time{}},
+		{86399.999999999, Time{23, 59, 59, 999999999}},
+	} {
+		got := Time{1, 2, 3, 4}
+		if err := got.Scan(tc.v); err != nil || got != tc.want {
+			t.Errorf("Scan(%v) = %v, %v; want %v", tc.v, got, err, tc.want)
+		}
+	}
+	for _, v := range []float64{-1, 86400, math.NaN()} {
+		var got Time
+		if err := got.Scan(v); err == nil {
+			t.Errorf("Scan(%v) = %v, want an error", v, got)
+		}
+	}
+}
+
+func TestDateScanYearMonth(t *testing.T) {
+	defer func(b bool) { ScanYearMonth = b }(ScanYearMonth)
+
+	// A truncated date is an error by default.
+	ScanYearMonth = false
+	var d Date
+	if err := d.Scan("2023-05"); err == nil {
+		t.Errorf(`Scan("2023-05") = %v, want an error`, d)
+	}
+
+	// With ScanYearMonth, a bare year and month is the first of the month.
+	ScanYearMonth = true
+	if err := d.Scan("2023-05"); err != nil || d != (Date{2023, 5, 1}) {
+		t.Errorf(`Scan("2023-05") = %v, %v; want 2023-05-01`, d, err)
+	}
+	for _, s := range []string{"2023-13", "2023-5", "2023-00"} {
+		if err := d.Scan(s); err == nil {
+			t.Errorf("Scan(%q) = %v, want an error", s, d)
+		}
+	}
+	// Other forms are unaffected.
+	if err := d.Scan("2023-05-02"); err != nil || d != (Date{2023, 5, 2}) {
+		t.Errorf(`Scan("2023-05-02") = %v, %v; want 2023-05-02`, d, err)
+	}
+}
+
+// TestStringConcurrent is meant to be run with -race: Date values are
+// shared read-only between goroutines, and formatting them must not
+// write to any shared state.
+func TestStringConcurrent(t *testing.T) {
+	dates := make([]Date, 365)
+	want := make([]string, len(dates))
+	for i := range dates {
+		dates[i] = Date{2023, 1, 1}.AddDays(i)
+		want[i] = dates[i].In(time.UTC).Format("2006-01-02")
+	}
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i, d := range dates {
+				if got := d.String(); got != want[i] {
+					t.Errorf("%#v.String() = %q, want %q", d, got, want[i])
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestZeroTimeAsNullDate(t *testing.T) {
+	defer func(b bool) { ZeroTimeAsNull = b }(ZeroTimeAsNull)
+	var zero time.Time
+	for _, tc := range []struct {
+		flag bool
+		want Date
+	}{
+		{false, Date{1, 1, 1}},
+		{true, Date{}},
+	} {
+		ZeroTimeAsNull = tc.flag
+		var d Date
+		if err := d.Scan(zero); err != nil || d != tc.want || d.IsZero() != tc.flag {
+			t.Errorf("ZeroTimeAsNull=%v: Scan(zero time) = %v, %v; want %v", tc.flag, d, err, tc.want)
+		}
+	}
+	// Only the exact zero time is affected.
+	ZeroTimeAsNull = true
+	var d Date
+	if err := d.Scan(zero.Add(time.Nanosecond)); err != nil || d != (Date{1, 1, 1}) {
+		t.Errorf("Scan(zero time + 1ns) = %v, %v; want 0001-01-01", d, err)
+	}
+}
+
+func TestTimeScanTIMETZ(t *testing.T) {
+	// TIMETZ values are converted to UTC.
+	for _, tc := range []struct {
+		s    string
+		want Time
+	}{
+		{"13:45:00+02", Time{Hour: 11, Minute: 45}},
+		{"13:45:00.5-03:30", Time{17, 15, 0, 500000000}},
+		{"13:45:00+0530", Time{Hour: 8, Minute: 15}},
+		{"01:00:00+02", Time{Hour: 23}},
+		{"23:00:00-02", Time{Hour: 1}},
+		{"13:45:00Z", Time{Hour: 13, Minute: 45}},
+	} {
+		got := Time{1, 2, 3, 4}
+		if err := got.Scan(tc.s); err != nil || got != tc.want {
+			t.Errorf("Scan(%q) = %v, %v; want %v", tc.s, got, err, tc.want)
+		}
+	}
+	for _, s := range []string{"13:45:00+25", "13:45:00 +02", "25:00:00+02"} {
+		var got Time
+		if err := got.Scan(s); err == nil {
+			t.Errorf("Scan(%q) = %v, want an error", s, got)
+		}
+	}
+}
+
+func TestParseDateTimeSnowflake(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		want DateTime
+		ok   bool
+	}{
+		{"2023-05-01 13:45:00.123 -0700", DateTime{Date{2023, 5, 1}, Time{20, 45, 0, 123000000}}, true},
+		{"2023-05-01 13:45:00 +0000", DateTime{Date{2023, 5, 1}, Time{Hour: 13, Minute: 45}}, true},
+		{"2023-12-31 23:30:00.5 -0100", DateTime{Date{2024, 1, 1}, Time{0, 30, 0, 500000000}}, true},
+		{"2023-05-01T13:45:00.123 -0700", DateTime{}, false},
+		{"2023-05-01 13:45:00.123-0700", DateTime{}, false},
+		{"2023-05-01 13:45:00.123", DateTime{}, false},
+	} {
+		got, err := ParseDateTimeSnowflake(tc.s)
+		if (err == nil) != tc.ok || got != tc.want {
+			t.Errorf("ParseDateTimeSnowflake(%q) = %v, %v; want %v, ok=%v", tc.s, got, err, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestDateTimeScanSnowflake(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		want DateTime
+	}{
+		{"2023-05-01 06:45:00.123 -0700", DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 123000000}}},
+		{"2023-05-01 15:45:00 +0200", DateTime{Date{2023, 5, 1}, Time{Hour: 13, Minute: 45}}},
+		{"2023-04-30 23:00:00 -0700", DateTime{Date{2023, 5, 1}, Time{Hour: 6}}},
+	} {
+		got := DateTime{Date{1999, 1, 1}, Time{1, 2, 3, 4}}
+		if err := got.Scan(tc.s); err != nil || got != tc.want {
+			t.Errorf("Scan(%q) = %v, %v; want %v", tc.s, got, err, tc.want)
+		}
+	}
+	var got DateTime
+	if err := got.Scan("2023-05-01 13:45:00 PDT"); err == nil {
+		t.Errorf("Scan of a zone name = %v, want an error", got)
+	}
+}
+
+// parseDateSlow is ParseDate without its fast path, as it was before the
+// canonical form was decoded by hand.
+func parseDateSlow(s string) (Date, error) {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+func TestParseDateFastPath(t *testing.T) {
+	for _, s := range []string{
+		"2023-05-01", "0001-01-01", "9999-12-31", "2024-02-29", "0000-01-01",
+		"2023-02-29", "2023-02-30", "2023-04-31", "2023-13-01", "2023-00-10", "2023-05-00",
+		"0000-00-00", "2023-5-01", "2023-05-1", "23-05-01", "2023/05/01", "2023-05-01 ",
+		" 2023-05-01", "2023-05-01T00:00:00", "+023-05-01", "2023-+5-01", "20230501", "",
+	} {
+		got, gotErr := ParseDate(s)
+		want, wantErr := parseDateSlow(s)
+		if got != want || fmt.Sprint(gotErr) != fmt.Sprint(wantErr) {
+			t.Errorf("ParseDate(%q) = %v, %v; without the fast path %v, %v", s, got, gotErr, want, wantErr)
+		}
+	}
+}
+
+// benchDates holds distinct canonical dates, like a DATE column of a large
+// result set.
+var benchDates = func() []string {
+	s := make([]string, 1000)
+	for i := range s {
+		s[i] = DateFromEpochDays(int64(19000 + i)).String()
+	}
+	return s
+}()
+
+func BenchmarkParseDate(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseDate(benchDates[i%len(benchDates)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkParseDateSlow is the baseline for BenchmarkParseDate.
+func BenchmarkParseDateSlow(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := parseDateSlow(benchDates[i%len(benchDates)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDateScan(b *testing.B) {
+	b.ReportAllocs()
+	var d Date
+	for i := 0; i < b.N; i++ {
+		if err := d.Scan(benchDates[i%len(benchDates)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestDateTimeClamp(t *testing.T) {
+	at := func(day, hour int) DateTime { return DateTime{Date{2023, 5, day}, Time{Hour: hour}} }
+	lo, hi := at(1, 9), at(1, 17)
+	for _, tc := range []struct {
+		dt, want DateTime
+	}{
+		{at(1, 8), lo},
+		{at(1, 9), lo},
+		{at(1, 12), at(1, 12)},
+		{at(1, 17), hi},
+		{at(2, 0), hi},
+		{DateTime{Date{2023, 5, 1}, Time{17, 0, 0, 1}}, hi},
+	} {
+		if got := tc.dt.Clamp(lo, hi); got != tc.want {
+			t.Errorf("%v.Clamp(%v, %v) = %v, want %v", tc.dt, lo, hi, got, tc.want)
+		}
+		// Swapped bounds describe the same window.
+		if got := tc.dt.Clamp(hi, lo); got != tc.want {
+			t.Errorf("%v.Clamp(%v, %v) = %v, want %v", tc.dt, hi, lo, got, tc.want)
+		}
+	}
+}
+
+func TestRFC3339(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		dt   DateTime
+		loc  *time.Location
+		want string
+	}{
+		{DateTime{Date{2023, 5, 1}, Time{Hour: 13, Minute: 45}}, time.UTC, "2023-05-01T13:45:00Z"},
+		{DateTime{Date{2023, 5, 1}, Time{Hour: 13, Minute: 45}}, ny, "2023-05-01T13:45:00-04:00"},
+		{DateTime{Date{2023, 1, 1}, Time{Hour: 13, Minute: 45}}, ny, "2023-01-01T13:45:00-05:00"},
+		{DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 500000000}}, time.FixedZone("", 5*60*60+30*60), "2023-05-01T13:45:00.5+05:30"},
+		{DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 123456789}}, time.UTC, "2023-05-01T13:45:00.123456789Z"},
+	} {
+		if got := tc.dt.RFC3339(tc.loc); got != tc.want {
+			t.Errorf("%v.RFC3339(%v) = %q, want %q", tc.dt, tc.loc, got, tc.want)
+		}
+	}
+}
+
+func TestDateScanText(t *testing.T) {
+	want := Date{2023, 5, 1}
+	for _, s := range []string{"Mon May 1 2023", "Monday, May 1, 2023", "monday, may 1, 2023"} {
+		var d Date
+		if err := d.Scan(s); err != nil || d != want {
+			t.Errorf("Scan(%q) = %v, %v; want %v", s, d, err, want)
+		}
+	}
+	var d Date
+	if err := d.Scan("May 32 2023"); err == nil {
+		t.Errorf(`Scan("May 32 2023") = %v, want an error`, d)
+	}
+}
+
+func TestScanPointers(t *testing.T) {
+	tm := time.Date(2023, 5, 1, 13, 45, 0, 0, time.UTC)
+	tmPtr := &tm
+	for _, tc := range []struct {
+		name string
+		v    any
+	}{
+		{"*time.Time", &tm},
+		{"**time.Time", &tmPtr},
+		{"sql.Out", sql.Out{Dest: &tm}},
+		{"*sql.Out", &sql.Out{Dest: &tmPtr}},
+	} {
+		var d Date
+		if err := d.Scan(tc.v); err != nil || d != (Date{2023, 5, 1}) {
+			t.Errorf("%s: Date.Scan = %v, %v; want 2023-05-01", tc.name, d, err)
+		}
+		var tt Time
+		if err := tt.Scan(tc.v); err != nil || tt != (Time{Hour: 13, Minute: 45}) {
+			t.Errorf("%s: Time.Scan = %v, %v; want 13:45:00", tc.name, tt, err)
+		}
+		var dt DateTime
+		if err := dt.Scan(tc.v); err != nil || dt != DateTimeOf(tm) {
+			t.Errorf("%s: DateTime.Scan = %v, %v; want %v", tc.name, dt, err, DateTimeOf(tm))
+		}
+	}
+
+	date, clock, dateTime := "2023-05-01", "13:45:00", "2023-05-01T13:45:00"
+	datePtr, clockPtr, dateTimePtr := &date, &clock, &dateTime
+	var d Date
+	for _, v := range []any{&date, &datePtr} {
+		if err := d.Scan(v); err != nil || d != (Date{2023, 5, 1}) {
+			t.Errorf("Date.Scan(%T) = %v, %v; want 2023-05-01", v, d, err)
+		}
+	}
+	var tt Time
+	for _, v := range []any{&clock, &clockPtr} {
+		if err := tt.Scan(v); err != nil || tt != (Time{Hour: 13, Minute: 45}) {
+			t.Errorf("Time.Scan(%T) = %v, %v; want 13:45:00", v, tt, err)
+		}
+	}
+	var dt DateTime
+	for _, v := range []any{&dateTime, &dateTimePtr} {
+		if err := dt.Scan(v); err != nil || dt != DateTimeOf(tm) {
+			t.Errorf("DateTime.Scan(%T) = %v, %v; want %v", v, dt, err, DateTimeOf(tm))
+		}
+	}
+}
+
+func TestScanNil(t *testing.T) {
+	var (
+		nilTime    *time.Time
+		nilString  *string
+		nilBytes   *[]byte
+		nilTimePtr **time.Time
+	)
+	for _, tc := range []struct {
+		name string
+		v    any
+	}{
+		{"nil", nil},
+		{"nil *time.Time", nilTime},
+		{"nil *string", nilString},
+		{"nil *[]byte", nilBytes},
+		{"nil **time.Time", nilTimePtr},
+		{"**time.Time to nil", &nilTime},
+		{"sql.Out of nil", sql.Out{Dest: nilTime}},
+		{"nil *sql.Out", (*sql.Out)(nil)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Date{2000, 1, 1}
+			if err := d.Scan(tc.v); err != nil || d != (Date{}) {
+				t.Errorf("Date.Scan = %v, %v; want zero Date", d, err)
+			}
+			tt := Time{1, 2, 3, 4}
+			if err := tt.Scan(tc.v); err != nil || tt != (Time{}) {
+				t.Errorf("Time.Scan = %v, %v; want zero Time", tt, err)
+			}
+			dt := DateTime{Date{2000, 1, 1}, Time{1, 2, 3, 4}}
+			if err := dt.Scan(tc.v); err != nil || dt != (DateTime{}) {
+				t.Errorf("DateTime.Scan = %v, %v; want zero DateTime", dt, err)
+			}
+		})
+	}
+}
+
+func TestDateTimeAdd(t *testing.T) {
+	for _, tc := range []struct {
+		dt   DateTime
+		d    time.Duration
+		want DateTime
+	}{
+		// Carries across midnight, month ends and year ends.
+		{DateTime{Date{2024, 1, 1}, Time{Hour: 23, Minute: 30}}, 2 * time.Hour, DateTime{Date{2024, 1, 2}, Time{Hour: 1, Minute: 30}}},
+		{DateTime{Date{2023, 5, 31}, Time{23, 59, 59, 999999999}}, time.Nanosecond, DateTime{Date: Date{2023, 6, 1}}},
+		{DateTime{Date{2023, 12, 31}, Time{Hour: 23, Minute: 30}}, time.Hour, DateTime{Date{2024, 1, 1}, Time{Minute: 30}}},
+		{DateTime{Date{2024, 2, 28}, Time{Hour: 12}}, 24 * time.Hour, DateTime{Date{2024, 2, 29}, Time{Hour: 12}}},
+		{DateTime{Date{2023, 2, 28}, Time{Hour: 12}}, 24 * time.Hour, DateTime{Date{2023, 3, 1}, Time{Hour: 12}}},
+		// Negative durations roll back the same way.
+		{DateTime{Date: Date{2024, 1, 1}}, -time.Nanosecond, DateTime{Date{2023, 12, 31}, Time{23, 59, 59, 999999999}}},
+		{DateTime{Date: Date{2023, 3, 1}}, -time.Minute, DateTime{Date{2023, 2, 28}, Time{Hour: 23, Minute: 59}}},
+		{DateTime{Date{2023, 5, 1}, Time{Hour: 1}}, -2 * time.Hour, DateTime{Date{2023, 4, 30}, Time{Hour: 23}}},
+		// Nanoseconds are kept.
+		{DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 123456789}}, time.Microsecond, DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 123457789}}},
+		// Daylight saving time plays no part.
+		{DateTime{Date{2023, 3, 12}, Time{Hour: 1}}, 2 * time.Hour, DateTime{Date{2023, 3, 12}, Time{Hour: 3}}},
+		{DateTime{Date{2023, 5, 1}, Time{Hour: 12}}, 0, DateTime{Date{2023, 5, 1}, Time{Hour: 12}}},
+	} {
+		if got := tc.dt.Add(tc.d); got != tc.want {
+			t.Errorf("%v.Add(%v) = %v, want %v", tc.dt, tc.d, got, tc.want)
+		}
+	}
+}
+
+func TestTimeScanHoursMinutes(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		want Time
+	}{
+		{"13:45", Time{Hour: 13, Minute: 45}},
+		{"00:00", Time{}},
+		{"23:59", Time{Hour: 23, Minute: 59}},
+		{"13:45:00", Time{Hour: 13, Minute: 45}},
+		{"13:45:30.5", Time{13, 45, 30, 500000000}},
+	} {
+		got := Time{1, 2, 3, 4}
+		if err := got.Scan(tc.s); err != nil || got != tc.want {
+			t.Errorf("Scan(%q) = %v, %v; want %v", tc.s, got, err, tc.want)
+		}
+		if got, err := ParseTime(tc.s); err != nil || got != tc.want {
+			t.Errorf("ParseTime(%q) = %v, %v; want %v", tc.s, got, err, tc.want)
+		}
+	}
+	for _, s := range []string{"24:00", "13:60", "1345", "13:4"} {
+		if got, err := ParseTime(s); err == nil {
+			t.Errorf("ParseTime(%q) = %v, want an error", s, got)
+		}
+	}
+}
+
+func TestCombineDateTime(t *testing.T) {
+	for _, tc := range []struct {
+		dateVal, timeVal any
+		want             DateTime
+	}{
+		{"2023-05-01", "13:45:00", DateTime{Date{2023, 5, 1}, Time{Hour: 13, Minute: 45}}},
+		{int64(19478), int64(49500e6), DateTime{Date{2023, 5, 1}, Time{Hour: 13, Minute: 45}}},
+		{time.Date(2023, 5, 1, 9, 0, 0, 0, time.UTC), []byte("13:45:00.5"), DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 500000000}}},
+	} {
+		if got, err := CombineDateTime(tc.dateVal, tc.timeVal); err != nil || got != tc.want {
+			t.Errorf("CombineDateTime(%v, %v) = %v, %v; want %v", tc.dateVal, tc.timeVal, got, err, tc.want)
+		}
+	}
+	if _, err := CombineDateTime("2023-02-30", "13:45:00"); err == nil || !strings.HasPrefix(err.Error(), "scanning date: ") {
+		t.Errorf("CombineDateTime with a bad date: error = %v, want a date error", err)
+	}
+	if _, err := CombineDateTime("2023-05-01", "25:00:00"); err == nil || !strings.HasPrefix(err.Error(), "scanning time: ") {
+		t.Errorf("CombineDateTime with a bad time: error = %v, want a time error", err)
+	}
+}
+
+func TestZeroTimeAsNullDateTime(t *testing.T) {
+	defer func(b bool) { ZeroTimeAsNull = b }(ZeroTimeAsNull)
+	var zero time.Time
+	for _, tc := range []struct {
+		flag bool
+		want DateTime
+	}{
+		{false, DateTime{Date: Date{1, 1, 1}}},
+		{true, DateTime{}},
+	} {
+		ZeroTimeAsNull = tc.flag
+		for _, v := range []any{zero, &zero, sql.NullTime{Time: zero, Valid: true}} {
+			var dt DateTime
+			if err := dt.Scan(v); err != nil || dt != tc.want || dt.IsZero() != tc.flag {
+				t.Errorf("ZeroTimeAsNull=%v: Scan(%T) = %v, %v; want %v", tc.flag, v, dt, err, tc.want)
+			}
+		}
+	}
+	// Only the exact zero time is affected.
+	ZeroTimeAsNull = true
+	var dt DateTime
+	if err := dt.Scan(zero.Add(time.Nanosecond)); err != nil || dt != (DateTime{Date{1, 1, 1}, Time{Nanosecond: 1}}) {
+		t.Errorf("Scan(zero time + 1ns) = %v, %v; want 0001-01-01T00:00:00.000000001", dt, err)
+	}
+}
+
+func TestCompareDateTime(t *testing.T) {
+	d := Date{2023, 5, 1}
+	for _, tc := range []struct {
+		dt   DateTime
+		want int
+	}{
+		{DateTime{Date: d}, 0},
+		{DateTime{d, Time{Nanosecond: 1}}, -1},
+		{DateTime{d, Time{23, 59, 59, 999999999}}, -1},
+		{DateTime{Date{2023, 4, 30}, Time{23, 59, 59, 999999999}}, +1},
+		{DateTime{Date: Date{2023, 5, 2}}, -1},
+	} {
+		if got := d.CompareDateTime(tc.dt); got != tc.want {
+			t.Errorf("%v.CompareDateTime(%v) = %d, want %d", d, tc.dt, got, tc.want)
+		}
+	}
+}
+
+func TestSortKey(t *testing.T) {
+	// Chronological order, including values whose String forms sort
+	// differently because a zero fraction is omitted.
+	sorted := []DateTime{
+		{Date{1, 1, 1}, Time{}},
+		{Date{2023, 5, 1}, Time{13, 45, 0, 0}},
+		{Date{2023, 5, 1}, Time{13, 45, 0, 1}},
+		{Date{2023, 5, 1}, Time{13, 45, 0, 500000000}},
+		{Date{2023, 5, 1}, Time{13, 45, 1, 0}},
+		{Date{2023, 5, 2}, Time{}},
+		{Date{9999, 12, 31}, Time{23, 59, 59, 999999999}},
+	}
+	for i := range sorted {
+		for j := range sorted {
+			a, b := sorted[i], sorted[j]
+			if got, want := strings.Compare(a.SortKey(), b.SortKey()), a.Compare(b); got != want {
+				t.Errorf("SortKey order of %v and %v = %d, want %d", a, b, got, want)
+			}
+			if got, want := strings.Compare(a.Time.SortKey(), b.Time.SortKey()), a.Time.Compare(b.Time); got != want {
+				t.Errorf("SortKey order of %v and %v = %d, want %d", a.Time, b.Time, got, want)
+			}
+		}
+	}
+	if got, want := sorted[1].SortKey(), "2023-05-01T13:45:00.000000000"; got != want {
+		t.Errorf("%v.SortKey() = %q, want %q", sorted[1], got, want)
+	}
+}
+
+func TestDateSub(t *testing.T) {
+	for _, tc := range []struct {
+		d, d2 Date
+		want  time.Duration
+	}{
+		{Date{2023, 5, 2}, Date{2023, 5, 1}, 24 * time.Hour},
+		{Date{2023, 5, 1}, Date{2023, 5, 2}, -24 * time.Hour},
+		{Date{2023, 5, 1}, Date{2023, 5, 1}, 0},
+		// Days that are 23 or 25 hours long in zones with daylight saving
+		// time are still 24 hours here.
+		{Date{2023, 3, 13}, Date{2023, 3, 12}, 24 * time.Hour},
+		{Date{2023, 3, 27}, Date{2023, 3, 26}, 24 * time.Hour},
+		{Date{2023, 11, 6}, Date{2023, 11, 5}, 24 * time.Hour},
+		{Date{2024, 3, 1}, Date{2024, 2, 1}, 29 * 24 * time.Hour},
+		// More than about 292 years apart saturates.
+		{Date{9999, 12, 31}, Date{1, 1, 1}, time.Duration(math.MaxInt64)},
+		{Date{1, 1, 1}, Date{9999, 12, 31}, time.Duration(math.MinInt64)},
+	} {
+		got := tc.d.Sub(tc.d2)
+		if got != tc.want {
+			t.Errorf("%v.Sub(%v) = %v, want %v", tc.d, tc.d2, got, tc.want)
+		}
+		if days := tc.d.DaysSince(tc.d2); tc.want != math.MaxInt64 && tc.want != math.MinInt64 && got != time.Duration(days)*24*time.Hour {
+			t.Errorf("%v.Sub(%v) = %v, inconsistent with DaysSince = %d", tc.d, tc.d2, got, days)
+		}
+	}
+}
+
+func TestDateTimeSub(t *testing.T) {
+	for _, tc := range []struct {
+		dt, dt2 DateTime
+		want    time.Duration
+	}{
+		{DateTime{Date{2023, 5, 2}, Time{Hour: 1}}, DateTime{Date{2023, 5, 1}, Time{Hour: 23}}, 2 * time.Hour},
+		{DateTime{Date{2023, 5, 1}, Time{Hour: 23}}, DateTime{Date{2023, 5, 2}, Time{Hour: 1}}, -2 * time.Hour},
+		// Sub-second differences keep their nanoseconds.
+		{DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 5}}, DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 2}}, 3 * time.Nanosecond},
+		{DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 2}}, DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 5}}, -3 * time.Nanosecond},
+		{DateTime{Date{2023, 5, 1}, Time{13, 45, 1, 0}}, DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 999999999}}, time.Nanosecond},
+		{DateTime{Date: Date{2024, 1, 1}}, DateTime{Date{2023, 12, 31}, Time{23, 59, 59, 500000000}}, 500 * time.Millisecond},
+		{DateTime{Date: Date{2023, 5, 1}}, DateTime{Date: Date{2023, 5, 1}}, 0},
+		// More than about 292 years apart saturates.
+		{DateTime{Date: Date{9999, 12, 31}}, DateTime{Date: Date{1, 1, 1}}, time.Duration(math.MaxInt64)},
+		{DateTime{Date: Date{1, 1, 1}}, DateTime{Date: Date{9999, 12, 31}}, time.Duration(math.MinInt64)},
+	} {
+		if got := tc.dt.Sub(tc.dt2); got != tc.want {
+			t.Errorf("%v.Sub(%v) = %v, want %v", tc.dt, tc.dt2, got, tc.want)
+		}
+	}
+}
+
+func TestScanMySQLZero(t *testing.T) {
+	for _, s := range []string{"0000-00-00", "0000-00-00 00:00:00"} {
+		d := Date{2000, 1, 1}
+		if err := d.Scan(s); err != nil || d != (Date{}) {
+			t.Errorf("Date.Scan(%q) = %v, %v; want the zero Date", s, d, err)
+		}
+	}
+	dt := DateTime{Date{2000, 1, 1}, Time{Hour: 1}}
+	if err := dt.Scan("0000-00-00 00:00:00"); err != nil || dt != (DateTime{}) {
+		t.Errorf(`DateTime.Scan("0000-00-00 00:00:00") = %v, %v; want the zero DateTime`, dt, err)
+	}
+	// Only the exact MySQL forms are zero values.
+	for _, s := range []string{"0000-00-00T00:00:00", "0000-00-01"} {
+		var d Date
+		if err := d.Scan(s); err == nil {
+			t.Errorf("Date.Scan(%q) = %v, want an error", s, d)
+		}
+	}
+}
+
+func TestTimeAdd(t *testing.T) {
+	for _, tc := range []struct {
+		t    Time
+		d    time.Duration
+		want Time
+		days int
+	}{
+		{Time{Hour: 13}, 30 * time.Minute, Time{Hour: 13, Minute: 30}, 0},
+		{Time{Hour: 23}, 2 * time.Hour, Time{Hour: 1}, 1},
+		{Time{Hour: 1}, -2 * time.Hour, Time{Hour: 23}, -1},
+		{Time{}, -time.Nanosecond, Time{23, 59, 59, 999999999}, -1},
+		{Time{23, 59, 59, 999999999}, time.Nanosecond, Time{}, 1},
+		{Time{13, 45, 59, 999999999}, 2 * time.Nanosecond, Time{13, 46, 0, 1}, 0},
+		{Time{Hour: 13}, 50 * time.Hour, Time{Hour: 15}, 2},
+		{Time{Hour: 13}, -50 * time.Hour, Time{Hour: 11}, -2},
+		{Time{Hour: 13}, 48 * time.Hour, Time{Hour: 13}, 2},
+		{Time{Hour: 13}, -48 * time.Hour, Time{Hour: 13}, -2},
+		{Time{Hour: 13}, 0, Time{Hour: 13}, 0},
+	} {
+		got, days := tc.t.Add(tc.d)
+		if got != tc.want || days != tc.days {
+			t.Errorf("%v.Add(%v) = %v, %d; want %v, %d", tc.t, tc.d, got, days, tc.want, tc.days)
+		}
+	}
+}
+
+func TestTimeSlot(t *testing.T) {
+	for _, tc := range []struct {
+		t    Time
+		slot time.Duration
+		want int
+	}{
+		{Time{}, time.Hour, 0},
+		{Time{13, 59, 59, 999999999}, time.Hour, 13},
+		{Time{Hour: 23, Minute: 59}, time.Hour, 23},
+		{Time{Hour: 13, Minute: 44}, 15 * time.Minute, 54},
+		{Time{Hour: 13, Minute: 45}, 15 * time.Minute, 55},
+		{Time{Hour: 23, Minute: 59}, 15 * time.Minute, 95},
+		// 7-hour slots do not divide the day; the last one is shorter.
+		{Time{Hour: 20, Minute: 59}, 7 * time.Hour, 2},
+		{Time{Hour: 21}, 7 * time.Hour, 3},
+		{Time{Hour: 23, Minute: 59}, 7 * time.Hour, 3},
+	} {
+		dt := DateTime{Date{2023, 5, 1}, tc.t}
+		if got := dt.TimeSlot(tc.slot); got != tc.want {
+			t.Errorf("%v.TimeSlot(%v) = %d, want %d", dt, tc.slot, got, tc.want)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("TimeSlot(0) did not panic")
+		}
+	}()
+	DateTime{}.TimeSlot(0)
+}
+
+func TestEqualWithin(t *testing.T) {
+	base := DateTime{Date{2023, 5, 1}, Time{Hour: 23, Minute: 59, Second: 59}}
+	for _, tc := range []struct {
+		other DateTime
+		tol   time.Duration
+		want  bool
+	}{
+		{base, 0, true},
+		{base.Add(time.Second), time.Second, true},
+		{base.Add(-time.Second), time.Second, true},
+		{base.Add(time.Second + 1), time.Second, false},
+		{base.Add(-time.Second - 1), time.Second, false},
+		{base.Add(2 * time.Second), 5 * time.Second, true}, // across midnight
+		{base, -time.Nanosecond, false},
+	} {
+		if got := base.EqualWithin(tc.other, tc.tol); got != tc.want {
+			t.Errorf("%v.EqualWithin(%v, %v) = %v, want %v", base, tc.other, tc.tol, got, tc.want)
+		}
+		if got := tc.other.EqualWithin(base, tc.tol); got != tc.want {
+			t.Errorf("%v.EqualWithin(%v, %v) = %v, want %v", tc.other, base, tc.tol, got, tc.want)
+		}
+	}
+}
+
+func TestJulianDay(t *testing.T) {
+	for _, tc := range []struct {
+		jdn int
+		d   Date
+	}{
+		{2451545, Date{2000, 1, 1}},
+		{2440588, Date{1970, 1, 1}},
+		{2299161, Date{1582, 10, 15}}, // first day of the Gregorian calendar
+		{2460432, Date{2024, 5, 1}},
+		{1721426, Date{1, 1, 1}},
+	} {
+		if got := DateFromJulianDay(tc.jdn); got != tc.d {
+			t.Errorf("DateFromJulianDay(%d) = %v, want %v", tc.jdn, got, tc.d)
+		}
+		if got := tc.d.JulianDay(); got != tc.jdn {
+			t.Errorf("%v.JulianDay() = %d, want %d", tc.d, got, tc.jdn)
+		}
+	}
+}
+
+func TestDateScanJulianDay(t *testing.T) {
+	defer func(m DateIntMode) { DateScanIntMode = m }(DateScanIntMode)
+	DateScanIntMode = IntJulianDay
+	want := Date{2023, 5, 1}
+	for _, v := range []any{int64(2460066), int(2460066), int32(2460066), uint(2460066), uint32(2460066), uint64(2460066)} {
+		var d Date
+		if err := d.Scan(v); err != nil || d != want {
+			t.Errorf("Scan(%T(%v)) = %v, %v; want %v", v, v, d, err, want)
+		}
+	}
+}
+
+func TestParseDateStrict(t *testing.T) {
+	for _, tc := range []struct {
+		s       string
+		want    Date
+		wantErr string
+	}{
+		{"2024-02-29", Date{2024, 2, 29}, ""},
+		{"2024-02-30", Date{}, "invalid date: 2024-02-30"},
+		{"2023-02-29", Date{}, "invalid date: 2023-02-29"},
+		{"2023-04-31", Date{}, "invalid date: 2023-04-31"},
+		{"2023-13-01", Date{}, "invalid date: 2023-13-01"},
+	} {
+		got, err := ParseDateStrict(tc.s)
+		if got != tc.want || fmt.Sprint(err) != fmt.Sprint(errOrNil(tc.wantErr)) {
+			t.Errorf("ParseDateStrict(%q) = %v, %v; want %v, %q", tc.s, got, err, tc.want, tc.wantErr)
+		}
+	}
+	// Malformed input gets ParseDate's error.
+	if _, err := ParseDateStrict("2023-5-1"); err == nil || strings.HasPrefix(err.Error(), "invalid date") {
+		t.Errorf(`ParseDateStrict("2023-5-1") error = %v, want ParseDate's error`, err)
+	}
+}
+
+// errOrNil returns an error with text s, or nil if s is empty.
+func errOrNil(s string) error {
+	if s == "" {
+		return nil
+	}
+	return errors.New(s)
+}
+
+func TestQuarterAndDayOfYear(t *testing.T) {
+	for _, tc := range []struct {
+		d         Date
+		quarter   int
+		dayOfYear int
+	}{
+		{Date{2023, 1, 1}, 1, 1},
+		{Date{2023, 3, 31}, 1, 90},
+		{Date{2024, 3, 31}, 1, 91},
+		{Date{2023, 4, 1}, 2, 91},
+		{Date{2023, 6, 30}, 2, 181},
+		{Date{2023, 7, 1}, 3, 182},
+		{Date{2023, 9, 30}, 3, 273},
+		{Date{2023, 10, 1}, 4, 274},
+		{Date{2023, 12, 31}, 4, 365},
+		{Date{2024, 12, 31}, 4, 366},
+	} {
+		if got := tc.d.Quarter(); got != tc.quarter {
+			t.Errorf("%v.Quarter() = %d, want %d", tc.d, got, tc.quarter)
+		}
+		if got := tc.d.DayOfYear(); got != tc.dayOfYear {
+			t.Errorf("%v.DayOfYear() = %d, want %d", tc.d, got, tc.dayOfYear)
+		}
+	}
+}
+
+func TestTimeScanBytes(t *testing.T) {
+	for _, tc := range []struct {
+		b    string
+		want Time
+	}{
+		{"13:45:00", Time{13, 45, 0, 0}},
+		{"13:45:00.123", Time{13, 45, 0, 123000000}},
+		{"13:45:00.123456", Time{13, 45, 0, 123456000}},
+		{"13:45:00.123456789", Time{13, 45, 0, 123456789}},
+		{"13:45:00.000000", Time{13, 45, 0, 0}},
+		{"13:45:00.123456 ", Time{13, 45, 0, 123456000}},
+		{" 13:45:00\n", Time{13, 45, 0, 0}},
+		{"\t13:45:00.123\r\n", Time{13, 45, 0, 123000000}},
+	} {
+		b := []byte(tc.b)
+		for _, v := range []any{b, &b} {
+			var got Time
+			if err := got.Scan(v); err != nil || got != tc.want {
+				t.Errorf("Scan(%T(%q)) = %v, %v; want %v", v, tc.b, got, err, tc.want)
+			}
+		}
+	}
+	var got Time
+	if err := got.Scan([]byte("13:45:00 .5")); err == nil {
+		t.Errorf("Scan of an inner space = %v, want an error", got)
+	}
+}
+
+func TestMidpoint(t *testing.T) {
+	for _, tc := range []struct {
+		a, b, want DateTime
+	}{
+		{DateTime{Date{2023, 5, 1}, Time{Hour: 10}}, DateTime{Date{2023, 5, 1}, Time{Hour: 12}}, DateTime{Date{2023, 5, 1}, Time{Hour: 11}}},
+		{DateTime{Date{2023, 5, 1}, Time{Hour: 22}}, DateTime{Date{2023, 5, 2}, Time{Hour: 4}}, DateTime{Date{2023, 5, 2}, Time{Hour: 1}}},
+		{DateTime{Date: Date{2023, 12, 31}}, DateTime{Date: Date{2024, 1, 2}}, DateTime{Date: Date{2024, 1, 1}}},
+		// Sub-second, and an odd number of nanoseconds rounds toward a.
+		{DateTime{Date{2023, 5, 1}, Time{Nanosecond: 100}}, DateTime{Date{2023, 5, 1}, Time{Nanosecond: 301}}, DateTime{Date{2023, 5, 1}, Time{Nanosecond: 200}}},
+		{DateTime{Date{2023, 5, 1}, Time{Nanosecond: 301}}, DateTime{Date{2023, 5, 1}, Time{Nanosecond: 100}}, DateTime{Date{2023, 5, 1}, Time{Nanosecond: 201}}},
+		{DateTime{Date{2023, 5, 1}, Time{Second: 1}}, DateTime{Date{2023, 5, 1}, Time{Second: 2}}, DateTime{Date{2023, 5, 1}, Time{Second: 1, Nanosecond: 500000000}}},
+		{DateTime{Date{2023, 5, 1}, Time{Hour: 12}}, DateTime{Date{2023, 5, 1}, Time{Hour: 12}}, DateTime{Date{2023, 5, 1}, Time{Hour: 12}}},
+	} {
+		if got := tc.a.Midpoint(tc.b); got != tc.want {
+			t.Errorf("%v.Midpoint(%v) = %v, want %v", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+// protoDate has the shape of the google.type.Date message.
+type protoDate struct {
+	Year, Month, Day int32
+}
+
+func TestDateScanProtoDate(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		v    any
+		want Date
+		ok   bool
+	}{
+		{"proto Date", &protoDate{2023, 5, 1}, Date{2023, 5, 1}, true},
+		{"nil proto Date", (*protoDate)(nil), Date{}, true},
+		{"empty proto Date", &protoDate{}, Date{}, true},
+		{"partial proto Date", &protoDate{Year: 2023, Month: 5}, Date{}, false},
+		{"invalid proto Date", &protoDate{2023, 2, 30}, Date{}, false},
+		{"proto Date by value", protoDate{2023, 5, 1}, Date{}, false},
+	} {
+		d := Date{1999, 1, 1}
+		err := d.Scan(tc.v)
+		if (err == nil) != tc.ok || (tc.ok && d != tc.want) {
+			t.Errorf("%s: Scan = %v, %v; want %v, ok=%v", tc.name, d, err, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestTimeAddMinutes(t *testing.T) {
+	for _, tc := range []struct {
+		t    Time
+		n    int
+		want Time
+		days int
+	}{
+		{Time{Hour: 13}, 45, Time{Hour: 13, Minute: 45}, 0},
+		{Time{Hour: 23, Minute: 30}, 45, Time{Minute: 15}, 1},
+		{Time{Minute: 15}, -30, Time{Hour: 23, Minute: 45}, -1},
+		{Time{Hour: 13, Second: 5}, 24 * 60 * 3, Time{Hour: 13, Second: 5}, 3},
+		{Time{Hour: 13}, -24*60 - 1, Time{Hour: 12, Minute: 59}, -1},
+		{Time{Hour: 13}, 0, Time{Hour: 13}, 0},
+		// Far more minutes than a Duration could hold.
+		{Time{Hour: 13}, math.MaxInt / 1440 * 1440, Time{Hour: 13}, math.MaxInt / 1440},
+	} {
+		got, days := tc.t.AddMinutes(tc.n)
+		if got != tc.want || days != tc.days {
+			t.Errorf("%v.AddMinutes(%d) = %v, %d; want %v, %d", tc.t, tc.n, got, days, tc.want, tc.days)
+		}
+	}
+}
+
+func TestMinMaxDate(t *testing.T) {
+	for _, tc := range []struct {
+		dates    []Date
+		min, max Date
+	}{
+		{[]Date{{2023, 5, 1}, {2021, 12, 31}, {2024, 1, 1}, {2022, 6, 15}}, Date{2021, 12, 31}, Date{2024, 1, 1}},
+		{[]Date{{2023, 5, 2}, {2023, 5, 1}, {2023, 5, 2}}, Date{2023, 5, 1}, Date{2023, 5, 2}},
+		{[]Date{{2023, 5, 1}}, Date{2023, 5, 1}, Date{2023, 5, 1}},
+		{nil, Date{}, Date{}},
+	} {
+		if got := MinDate(tc.dates...); got != tc.min {
+			t.Errorf("MinDate(%v) = %v, want %v", tc.dates, got, tc.min)
+		}
+		if got := MaxDate(tc.dates...); got != tc.max {
+			t.Errorf("MaxDate(%v) = %v, want %v", tc.dates, got, tc.max)
+		}
+	}
+}
+
+func TestMinMaxDateTime(t *testing.T) {
+	a := DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 0}}
+	b := DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 1}}
+	c := DateTime{Date{2023, 4, 30}, Time{23, 59, 59, 0}}
+	for _, tc := range []struct {
+		dts      []DateTime
+		min, max DateTime
+	}{
+		{[]DateTime{a, b, c}, c, b},
+		{[]DateTime{b, c, a}, c, b},
+		{[]DateTime{a}, a, a},
+		{nil, DateTime{}, DateTime{}},
+	} {
+		if got := MinDateTime(tc.dts...); got != tc.min {
+			t.Errorf("MinDateTime(%v) = %v, want %v", tc.dts, got, tc.min)
+		}
+		if got := MaxDateTime(tc.dts...); got != tc.max {
+			t.Errorf("MaxDateTime(%v) = %v, want %v", tc.dts, got, tc.max)
+		}
+	}
+}
+
+func TestDateClamp(t *testing.T) {
+	lo, hi := Date{2023, 1, 1}, Date{2023, 12, 31}
+	for _, tc := range []struct {
+		d, want Date
+	}{
+		{Date{2022, 12, 31}, lo}, // below the range
+		{lo, lo},                 // equal to the lower bound
+		{Date{2023, 6, 15}, Date{2023, 6, 15}},
+		{hi, hi},               // equal to the upper bound
+		{Date{2024, 1, 1}, hi}, // above the range
+	} {
+		if got := tc.d.Clamp(lo, hi); got != tc.want {
+			t.Errorf("%v.Clamp(%v, %v) = %v, want %v", tc.d, lo, hi, got, tc.want)
+		}
+		// Swapped bounds describe the same range.
+		if got := tc.d.Clamp(hi, lo); got != tc.want {
+			t.Errorf("%v.Clamp(%v, %v) = %v, want %v", tc.d, hi, lo, got, tc.want)
+		}
+	}
+}
+
+func TestBetween(t *testing.T) {
+	start, end := Date{2023, 1, 1}, Date{2023, 1, 31}
+	for _, tc := range []struct {
+		d                    Date
+		inclusive, exclusive bool
+	}{
+		{Date{2022, 12, 31}, false, false},
+		{start, true, false},
+		{Date{2023, 1, 15}, true, true},
+		{end, true, false},
+		{Date{2023, 2, 1}, false, false},
+	} {
+		if got := tc.d.Between(start, end, true); got != tc.inclusive {
+			t.Errorf("%v.Between(%v, %v, true) = %v, want %v", tc.d, start, end, got, tc.inclusive)
+		}
+		if got := tc.d.Between(start, end, false); got != tc.exclusive {
+			t.Errorf("%v.Between(%v, %v, false) = %v, want %v", tc.d, start, end, got, tc.exclusive)
+		}
+		// A reversed range contains nothing.
+		if tc.d.Between(end, start, true) || tc.d.Between(end, start, false) {
+			t.Errorf("%v.Between(%v, %v) = true, want false", tc.d, end, start)
+		}
+	}
+	// A single-day range.
+	if !start.Between(start, start, true) || start.Between(start, start, false) {
+		t.Errorf("%v.Between(itself, itself) wrong", start)
+	}
+
+	tStart, tEnd := Time{Hour: 9}, Time{Hour: 17}
+	for _, tc := range []struct {
+		t                    Time
+		inclusive, exclusive bool
+	}{
+		{Time{8, 59, 59, 999999999}, false, false},
+		{tStart, true, false},
+		{Time{Hour: 12}, true, true},
+		{tEnd, true, false},
+		{Time{17, 0, 0, 1}, false, false},
+	} {
+		if got := tc.t.Between(tStart, tEnd, true); got != tc.inclusive {
+			t.Errorf("%v.Between(%v, %v, true) = %v, want %v", tc.t, tStart, tEnd, got, tc.inclusive)
+		}
+		if got := tc.t.Between(tStart, tEnd, false); got != tc.exclusive {
+			t.Errorf("%v.Between(%v, %v, false) = %v, want %v", tc.t, tStart, tEnd, got, tc.exclusive)
+		}
+		// A range across midnight is not treated as wrapping.
+		if tc.t.Between(tEnd, tStart, true) {
+			t.Errorf("%v.Between(%v, %v, true) = true, want false", tc.t, tEnd, tStart)
+		}
+		dt := DateTime{Date{2023, 5, 1}, tc.t}
+		s, e := DateTime{Date{2023, 5, 1}, tStart}, DateTime{Date{2023, 5, 1}, tEnd}
+		if got := dt.Between(s, e, true); got != tc.inclusive {
+			t.Errorf("%v.Between(%v, %v, true) = %v, want %v", dt, s, e, got, tc.inclusive)
+		}
+		if got := dt.Between(s, e, false); got != tc.exclusive {
+			t.Errorf("%v.Between(%v, %v, false) = %v, want %v", dt, s, e, got, tc.exclusive)
+		}
+		if dt.Between(e, s, true) {
+			t.Errorf("%v.Between(%v, %v, true) = true, want false", dt, e, s)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+// Package bigqueryGoDate provides civil Date, Time and DateTime types that
+// map to the BigQuery DATE, TIME and DATETIME types and implement the
+// database/sql Scanner and driver.Valuer interfaces.
+//
+// # Nullable values
+//
+// NullDate, NullTime and NullDateTime are instances of the generic Null
+// type. They follow the sql.NullTime conventions, with one difference: the
+// value is held in the field V for every type, not in a field named after
+// the type, so it is NullDate{V: d, Valid: true} where sql.NullTime would
+// use a Time field.
+//
+// # Concurrency
+//
+// Date, Time and DateTime are small comparable values with no hidden
+// state, so their methods, including String, are safe for concurrent use
+// and need no caching or locking. The package-level options such as
+// NormalizeOnScan are read without synchronization and should be set during
+// program initialization, before any concurrent Scan calls.
+package bigqueryGoDate
@@ -0,0 +1,96 @@
+package bigqueryGoDate
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Format implements the fmt.Formatter interface. The verbs %v and %s
+// produce d.String() and %q produces it quoted; width and the '-' flag pad
+// the result as they do for strings. %#v produces a Go-syntax
+// representation of the struct.
+func (d Date) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "%T{Year:%d, Month:%d, Day:%d}", d, d.Year, int(d.Month), d.Day)
+		return
+	}
+	formatString(f, verb, d, d.String())
+}
+
+// Format implements the fmt.Formatter interface. The verbs behave as for
+// Date. The precision, if given, sets the number of fractional digits as in
+// DateTime.StringPrecision, so %.3v produces millisecond output; without a
+// precision the result is t.String().
+func (t Time) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "%T{Hour:%d, Minute:%d, Second:%d, Nanosecond:%d}", t, t.Hour, t.Minute, t.Second, t.Nanosecond)
+		return
+	}
+	s := t.String()
+	if p, ok := f.Precision(); ok {
+		s = t.stringPrecision(p)
+	}
+	formatString(f, verb, t, s)
+}
+
+// Format implements the fmt.Formatter interface. The verbs and precision
+// behave as for Time.
+func (dt DateTime) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "%T{Date:%#v, Time:%#v}", dt, dt.Date, dt.Time)
+		return
+	}
+	s := dt.String()
+	if p, ok := f.Precision(); ok {
+		s = dt.StringPrecision(p)
+	}
+	formatString(f, verb, dt, s)
+}
+
+// FormatLayout returns d formatted according to layout, a Go reference
+// layout such as "Jan 2, 2006" or "02/01/2006", as interpreted by
+// time.Time.Format. Time-of-day fields in the layout format as midnight
+// and zone fields as UTC.
+func (d Date) FormatLayout(layout string) string {
+	return d.In(time.UTC).Format(layout)
+}
+
+// FormatLayout returns t formatted according to layout, as interpreted by
+// time.Time.Format. Date fields in the layout format as January 1 of year
+// 0 and zone fields as UTC.
+func (t Time) FormatLayout(layout string) string {
+	return time.Date(0, time.January, 1, t.Hour, t.Minute, t.Second, t.Nanosecond, time.UTC).Format(layout)
+}
+
+// FormatLayout returns dt formatted according to layout, as interpreted by
+// time.Time.Format. Zone fields in the layout format as UTC.
+func (dt DateTime) FormatLayout(layout string) string {
+	return dt.In(time.UTC).Format(layout)
+}
+
+// formatString writes s to f for the verbs %v, %s and %q, honoring the
+// width and flags but not the precision, which the callers have already
+// applied. Other verbs are reported the way package fmt reports bad verbs.
+func formatString(f fmt.State, verb rune, v any, s string) {
+	switch verb {
+	case 'v', 's', 'q':
+	default:
+		fmt.Fprintf(f, "%%!%c(%T=%s)", verb, v, s)
+		return
+	}
+	format := []byte{'%'}
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			format = append(format, byte(flag))
+		}
+	}
+	if w, ok := f.Width(); ok {
+		format = strconv.AppendInt(format, int64(w), 10)
+	}
+	if verb == 'v' {
+		verb = 's'
+	}
+	format = append(format, byte(verb))
+	fmt.Fprintf(f, string(format), s)
+}
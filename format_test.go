@@ -0,0 +1,81 @@
+package bigqueryGoDate
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestFormatVerbs(t *testing.T) {
+	d := Date{2023, 5, 1}
+	tm := Time{13, 45, 0, 123456789}
+	dt := DateTime{d, tm}
+	for _, tc := range []struct {
+		format string
+		v      any
+		want   string
+	}{
+		{"%v", d, "2023-05-01"},
+		{"%s", d, "2023-05-01"},
+		{"%q", d, `"2023-05-01"`},
+		{"%12v", d, "  2023-05-01"},
+		{"%-12s|", d, "2023-05-01  |"},
+		{"%#v", d, "bigqueryGoDate.Date{Year:2023, Month:5, Day:1}"},
+		{"%d", d, "%!d(bigqueryGoDate.Date=2023-05-01)"},
+
+		{"%v", tm, "13:45:00.123456789"},
+		{"%.3v", tm, "13:45:00.123"},
+		{"%.6s", tm, "13:45:00.123456"},
+		{"%.0v", tm, "13:45:00"},
+		{"%.12v", tm, "13:45:00.123456789"},
+		{"%14.3v", tm, "  13:45:00.123"},
+		{"%.3q", tm, `"13:45:00.123"`},
+		{"%v", Time{Hour: 9}, "09:00:00"},
+		{"%.3v", Time{Hour: 9}, "09:00:00.000"},
+		{"%#v", tm, "bigqueryGoDate.Time{Hour:13, Minute:45, Second:0, Nanosecond:123456789}"},
+		{"%x", tm, "%!x(bigqueryGoDate.Time=13:45:00.123456789)"},
+
+		{"%v", dt, "2023-05-01T13:45:00.123456789"},
+		{"%.6v", dt, "2023-05-01T13:45:00.123456"},
+		{"%.0s", dt, "2023-05-01T13:45:00"},
+		{"%-24.1v|", dt, "2023-05-01T13:45:00.1   |"},
+		{"%#v", dt, "bigqueryGoDate.DateTime{Date:bigqueryGoDate.Date{Year:2023, Month:5, Day:1}, Time:bigqueryGoDate.Time{Hour:13, Minute:45, Second:0, Nanosecond:123456789}}"},
+
+		// Values inside other values format the same way.
+		{"%v", []Date{d, d.AddDays(1)}, "[2023-05-01 2023-05-02]"},
+		{"%.3v", struct{ T Time }{tm}, "{13:45:00.123}"},
+	} {
+		if got := fmt.Sprintf(tc.format, tc.v); got != tc.want {
+			t.Errorf("Sprintf(%q, %#v) = %q, want %q", tc.format, tc.v, got, tc.want)
+		}
+	}
+}
+
+func TestFormatLayout(t *testing.T) {
+	d := Date{2023, 5, 1}
+	tm := Time{13, 45, 7, 500000000}
+	dt := DateTime{d, tm}
+	for _, tc := range []struct {
+		name, got, want string
+	}{
+		{"Date", d.FormatLayout("Jan 2, 2006"), "May 1, 2023"},
+		{"Date", d.FormatLayout("02/01/2006"), "01/05/2023"},
+		{"Date", d.FormatLayout("Monday 2006-01-02"), "Monday 2023-05-01"},
+		// Time-of-day and zone fields of a Date are midnight UTC.
+		{"Date", d.FormatLayout("2006-01-02 15:04:05 MST"), "2023-05-01 00:00:00 UTC"},
+
+		{"Time", tm.FormatLayout("3:04PM"), "1:45PM"},
+		{"Time", tm.FormatLayout("15:04:05.000"), "13:45:07.500"},
+		{"Time", Time{}.FormatLayout("3:04:05 PM"), "12:00:00 AM"},
+
+		{"DateTime", dt.FormatLayout("2006-01-02 15:04:05.00"), "2023-05-01 13:45:07.50"},
+		{"DateTime", dt.FormatLayout("Jan 2 3PM"), "May 1 1PM"},
+		// A date-only layout ignores the time of day.
+		{"DateTime", dt.FormatLayout("2006-01-02"), "2023-05-01"},
+		{"DateTime", dt.FormatLayout(time.RFC3339), "2023-05-01T13:45:07Z"},
+	} {
+		if tc.got != tc.want {
+			t.Errorf("%s.FormatLayout = %q, want %q", tc.name, tc.got, tc.want)
+		}
+	}
+}
@@ -3,3 +3,14 @@ module github.com/juaismar/bigqueryGoDate
 go 1.23.0
 
 require cloud.google.com/go v0.120.0
+
+require (
+	gopkg.in/yaml.v3 v3.0.1
+	gorm.io/gorm v1.31.2
+)
+
+require (
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+	golang.org/x/text v0.23.0 // indirect
+)
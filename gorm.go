@@ -0,0 +1,66 @@
+//go:build gorm
+
+package bigqueryGoDate
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// This file is only built with the "gorm" build tag, so that programs that
+// do not use GORM do not have to compile it.
+
+// GormDataType returns the general GORM data type of Date.
+func (Date) GormDataType() string {
+	return "date"
+}
+
+// GormDBDataType returns the column type of Date for the dialect of db.
+func (Date) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "bigquery":
+		return "DATE"
+	default:
+		return "date"
+	}
+}
+
+// GormDataType returns the general GORM data type of Time.
+func (Time) GormDataType() string {
+	return "time"
+}
+
+// GormDBDataType returns the column type of Time for the dialect of db.
+// MySQL is given microsecond precision, which it otherwise truncates.
+func (Time) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "bigquery":
+		return "TIME"
+	case "mysql":
+		return "time(6)"
+	default:
+		return "time"
+	}
+}
+
+// GormDataType returns the general GORM data type of DateTime.
+func (DateTime) GormDataType() string {
+	return "datetime"
+}
+
+// GormDBDataType returns the column type of DateTime for the dialect of db.
+// Each dialect is given its zone-less timestamp type.
+func (DateTime) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "bigquery":
+		return "DATETIME"
+	case "mysql":
+		return "datetime(6)"
+	case "postgres":
+		return "timestamp"
+	case "sqlserver":
+		return "datetime2"
+	default:
+		return "datetime"
+	}
+}
@@ -0,0 +1,54 @@
+//go:build gorm
+
+package bigqueryGoDate
+
+import (
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+// namedDialector is a gorm.Dialector that only reports a name, which is all
+// GormDBDataType looks at.
+type namedDialector struct {
+	gorm.Dialector
+	name string
+}
+
+func (d namedDialector) Name() string { return d.name }
+
+func TestGormDBDataType(t *testing.T) {
+	for _, tc := range []struct {
+		dialect            string
+		date, tm, dateTime string
+	}{
+		{"bigquery", "DATE", "TIME", "DATETIME"},
+		{"mysql", "date", "time(6)", "datetime(6)"},
+		{"postgres", "date", "time", "timestamp"},
+		{"sqlserver", "date", "time", "datetime2"},
+		{"sqlite", "date", "time", "datetime"},
+	} {
+		db := &gorm.DB{Config: &gorm.Config{Dialector: namedDialector{name: tc.dialect}}}
+		if got := (Date{}).GormDBDataType(db, nil); got != tc.date {
+			t.Errorf("%s: Date column type = %q, want %q", tc.dialect, got, tc.date)
+		}
+		if got := (Time{}).GormDBDataType(db, nil); got != tc.tm {
+			t.Errorf("%s: Time column type = %q, want %q", tc.dialect, got, tc.tm)
+		}
+		if got := (DateTime{}).GormDBDataType(db, nil); got != tc.dateTime {
+			t.Errorf("%s: DateTime column type = %q, want %q", tc.dialect, got, tc.dateTime)
+		}
+	}
+}
+
+func TestGormDataType(t *testing.T) {
+	for _, tc := range []struct{ got, want string }{
+		{Date{}.GormDataType(), "date"},
+		{Time{}.GormDataType(), "time"},
+		{DateTime{}.GormDataType(), "datetime"},
+	} {
+		if tc.got != tc.want {
+			t.Errorf("GormDataType() = %q, want %q", tc.got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package bigqueryGoDate
+
+import "fmt"
+
+// Humanize describes d relative to asOf in English, for example "today",
+// "yesterday", "in 3 days", "2 weeks ago" or "in 1 year". The distance in
+// days, n = d.DaysSince(asOf), is expressed in the largest unit that fits,
+// truncating toward zero:
+//
+//	|n| < 7      days
+//	|n| < 30     weeks (7 days)
+//	|n| < 365    months (30 days)
+//	otherwise    years (365 days)
+//
+// Humanize is English-only. Localized output would replace humanizePhrase,
+// which receives the signed count and unit and is the only place that
+// produces words.
+func (d Date) Humanize(asOf Date) string {
+	n := d.DaysSince(asOf)
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+	switch {
+	case abs < 7:
+		return humanizePhrase(n, "day")
+	case abs < 30:
+		return humanizePhrase(n/7, "week")
+	case abs < 365:
+		return humanizePhrase(n/30, "month")
+	default:
+		return humanizePhrase(n/365, "year")
+	}
+}
+
+// humanizePhrase returns the English phrase for n units in the future, or
+// -n units in the past if n is negative.
+func humanizePhrase(n int, unit string) string {
+	if unit == "day" {
+		switch n {
+		case 0:
+			return "today"
+		case 1:
+			return "tomorrow"
+		case -1:
+			return "yesterday"
+		}
+	}
+	if n < 0 {
+		n = -n
+		if n != 1 {
+			unit += "s"
+		}
+		return fmt.Sprintf("%d %s ago", n, unit)
+	}
+	if n != 1 {
+		unit += "s"
+	}
+	return fmt.Sprintf("in %d %s", n, unit)
+}
@@ -0,0 +1,35 @@
+package bigqueryGoDate
+
+import "testing"
+
+func TestHumanize(t *testing.T) {
+	asOf := Date{2023, 5, 15}
+	for _, tc := range []struct {
+		days int
+		want string
+	}{
+		{0, "today"},
+		{1, "tomorrow"},
+		{-1, "yesterday"},
+		{2, "in 2 days"},
+		{-6, "6 days ago"},
+		{7, "in 1 week"},
+		{-7, "1 week ago"},
+		{13, "in 1 week"},
+		{-14, "2 weeks ago"},
+		{29, "in 4 weeks"},
+		{30, "in 1 month"},
+		{-59, "1 month ago"},
+		{-60, "2 months ago"},
+		{364, "in 12 months"},
+		{365, "in 1 year"},
+		{-365, "1 year ago"},
+		{-1000, "2 years ago"},
+		{3650, "in 10 years"},
+	} {
+		d := asOf.AddDays(tc.days)
+		if got := d.Humanize(asOf); got != tc.want {
+			t.Errorf("%v.Humanize(%v) = %q, want %q", d, asOf, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package bigqueryGoDate
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// MarshalJSON implements the json.Marshaler interface.
+// The output is d.String() as a JSON string.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return strconv.AppendQuote(nil, d.String()), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts a
+// JSON string in a format accepted by ParseDate. A JSON null sets d to the
+// zero Date.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*d = Date{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The output is t.String() as a JSON string.
+func (t Time) MarshalJSON() ([]byte, error) {
+	return strconv.AppendQuote(nil, t.String()), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts a
+// JSON string in a format accepted by ParseTime. A JSON null sets t to the
+// zero Time.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = Time{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.UnmarshalText([]byte(s))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The output is dt.String() as a JSON string.
+func (dt DateTime) MarshalJSON() ([]byte, error) {
+	return strconv.AppendQuote(nil, dt.String()), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts a
+// JSON string in a format accepted by ParseDateTime. A JSON null sets dt to
+// the zero DateTime.
+func (dt *DateTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*dt = DateTime{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return dt.UnmarshalText([]byte(s))
+}
+
+// An ObjectDate is a Date that is encoded in JSON as an object of the form
+//
+//	{"year":2023,"month":5,"day":1}
+//
+// rather than as a string. Convert to and from Date with ObjectDate(d) and
+// Date(od).
+type ObjectDate Date
+
+type objectDate struct {
+	Year  int `json:"year"`
+	Month int `json:"month"`
+	Day   int `json:"day"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (od ObjectDate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(objectDate{Year: od.Year, Month: int(od.Month), Day: od.Day})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The object must describe a valid date. A JSON null leaves od unchanged.
+func (od *ObjectDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var o objectDate
+	if err := json.Unmarshal(data, &o); err != nil {
+		return err
+	}
+	d := Date{Year: o.Year, Month: time.Month(o.Month), Day: o.Day}
+	if !d.IsValid() {
+		return fmt.Errorf("invalid date: %v", d)
+	}
+	*od = ObjectDate(d)
+	return nil
+}
+
+// An EpochDate is a Date that is encoded in JSON as the bare number of days
+// since 1970-01-01, as returned by Date.EpochDays. Convert to and from Date
+// with EpochDate(d) and Date(ed).
+type EpochDate Date
+
+// MarshalJSON implements the json.Marshaler interface.
+func (ed EpochDate) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, Date(ed).EpochDays(), 10), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// A JSON null leaves ed unchanged.
+func (ed *EpochDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var n int64
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*ed = EpochDate(DateFromEpochDays(n))
+	return nil
+}
@@ -0,0 +1,112 @@
+package bigqueryGoDate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type jsonRow struct {
+	D  Date     `json:"d"`
+	T  Time     `json:"t"`
+	DT DateTime `json:"dt"`
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	in := jsonRow{
+		D:  Date{2023, 5, 1},
+		T:  Time{13, 45, 0, 500000000},
+		DT: DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 123456000}},
+	}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = `{"d":"2023-05-01","t":"13:45:00.500000000","dt":"2023-05-01T13:45:00.123456000"}`
+	if string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+	var out jsonRow
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out != in {
+		t.Errorf("round trip = %+v, want %+v", out, in)
+	}
+}
+
+func TestJSONNull(t *testing.T) {
+	out := jsonRow{Date{2023, 5, 1}, Time{Hour: 13}, DateTime{Date{2023, 5, 1}, Time{Hour: 13}}}
+	if err := json.Unmarshal([]byte(`{"d":null,"t":null,"dt":null}`), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out != (jsonRow{}) {
+		t.Errorf("Unmarshal of nulls = %+v, want zero values", out)
+	}
+}
+
+func TestJSONErrors(t *testing.T) {
+	for _, data := range []string{
+		`{"d":"2023-02-30"}`, `{"d":20230501}`, `{"t":"25:00:00"}`, `{"t":true}`,
+		`{"dt":"2023-05-01X13:45:00"}`, `{"dt":{}}`,
+	} {
+		var out jsonRow
+		if err := json.Unmarshal([]byte(data), &out); err == nil {
+			t.Errorf("Unmarshal(%s) = %+v, want an error", data, out)
+		}
+	}
+}
+
+func TestObjectDate(t *testing.T) {
+	od := ObjectDate(Date{2023, 5, 1})
+	data, err := json.Marshal(od)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"year":2023,"month":5,"day":1}`; string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+	var got ObjectDate
+	if err := json.Unmarshal(data, &got); err != nil || got != od {
+		t.Errorf("Unmarshal(%s) = %v, %v; want %v", data, got, err, od)
+	}
+
+	// A null leaves the value unchanged.
+	if err := json.Unmarshal([]byte("null"), &got); err != nil || got != od {
+		t.Errorf("Unmarshal(null) = %v, %v; want %v unchanged", got, err, od)
+	}
+	for _, data := range []string{
+		`{"year":2023,"month":2,"day":29}`, `{"year":2023,"month":13,"day":1}`, `{}`, `"2023-05-01"`,
+	} {
+		if err := json.Unmarshal([]byte(data), &got); err == nil {
+			t.Errorf("Unmarshal(%s) = %v, want an error", data, got)
+		}
+	}
+}
+
+func TestEpochDate(t *testing.T) {
+	for _, tc := range []struct {
+		d    Date
+		json string
+	}{
+		{Date{1970, 1, 1}, "0"},
+		{Date{2023, 5, 1}, "19478"},
+		{Date{1969, 12, 31}, "-1"},
+	} {
+		data, err := json.Marshal(EpochDate(tc.d))
+		if err != nil || string(data) != tc.json {
+			t.Errorf("Marshal(%v) = %s, %v; want %s", tc.d, data, err, tc.json)
+		}
+		var got EpochDate
+		if err := json.Unmarshal([]byte(tc.json), &got); err != nil || Date(got) != tc.d {
+			t.Errorf("Unmarshal(%s) = %v, %v; want %v", tc.json, Date(got), err, tc.d)
+		}
+	}
+
+	got := EpochDate(Date{2023, 5, 1})
+	if err := json.Unmarshal([]byte("null"), &got); err != nil || Date(got) != (Date{2023, 5, 1}) {
+		t.Errorf("Unmarshal(null) = %v, %v; want 2023-05-01 unchanged", Date(got), err)
+	}
+	if err := json.Unmarshal([]byte(`"19478"`), &got); err == nil {
+		t.Error(`Unmarshal("19478") succeeded, want an error`)
+	}
+}
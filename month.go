@@ -0,0 +1,104 @@
+package bigqueryGoDate
+
+import (
+	"fmt"
+	"time"
+)
+
+// WeekdaysInMonth returns every date in the given month that falls on
+// weekday w, in ascending order. For example, WeekdaysInMonth(2023, time.May,
+// time.Monday) returns the five Mondays of May 2023.
+func WeekdaysInMonth(year int, month time.Month, w time.Weekday) []Date {
+	d := onOrAfterWeekday(Date{Year: year, Month: month, Day: 1}, w)
+	var dates []Date
+	for d.Month == month {
+		dates = append(dates, d)
+		d = d.AddDays(7)
+	}
+	return dates
+}
+
+// StartOfMonth returns the first day of the month containing d.
+func (d Date) StartOfMonth() Date {
+	return Date{Year: d.Year, Month: d.Month, Day: 1}
+}
+
+// EndOfMonth returns the last day of the month containing d, taking leap
+// years into account.
+func (d Date) EndOfMonth() Date {
+	return lastOfMonth(d.Year, d.Month)
+}
+
+// onOrAfterWeekday returns the first date on or after d that falls on
+// weekday w.
+func onOrAfterWeekday(d Date, w time.Weekday) Date {
+	return d.AddDays((int(w) - int(d.Weekday()) + 7) % 7)
+}
+
+// lastOfMonth returns the last day of the given month.
+func lastOfMonth(year int, month time.Month) Date {
+	return DateOf(time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC))
+}
+
+// IsStartOfMonth reports whether d is the first day of its month.
+func (d Date) IsStartOfMonth() bool {
+	return d.Day == 1
+}
+
+// IsEndOfMonth reports whether d is the last day of its month, taking leap
+// years into account.
+func (d Date) IsEndOfMonth() bool {
+	return d.Day == d.EndOfMonth().Day
+}
+
+// ParseYearMonth parses a string of the form YYYY-MM and returns the first
+// day of that month.
+func ParseYearMonth(s string) (Date, error) {
+	t, err := time.Parse("2006-01", s)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+// YearMonthString returns the year and month of d in the form YYYY-MM
+// accepted by ParseYearMonth.
+func (d Date) YearMonthString() string {
+	return fmt.Sprintf("%04d-%02d", d.Year, d.Month)
+}
+
+// AddMonths returns the date n calendar months after d; n can also be
+// negative to go into the past. Unlike time.Time.AddDate, which normalizes
+// overflow (January 31 plus one month is March 2 or 3), the day is clamped
+// to the last day of the target month, so 2024-01-31 plus one month is
+// 2024-02-29 and 2023-03-31 minus one month is 2023-02-28.
+func (d Date) AddMonths(n int) Date {
+	first := Date{Year: d.Year, Month: d.Month + time.Month(n), Day: 1}.normalize()
+	return clampedDay(first.Year, first.Month, d.Day)
+}
+
+// AddYears returns the date n years after d, clamping February 29 to
+// February 28 in non-leap years as described for AddMonths.
+func (d Date) AddYears(n int) Date {
+	return d.AddMonths(12 * n)
+}
+
+// NextDayOfMonth returns the first date after d whose day of the month is
+// day, where in months shorter than day the last day of the month is used
+// instead. For example, with day 31 the dates after 2024-01-31 are
+// 2024-02-29, 2024-03-31 and 2024-04-30. day is clamped to the range 1-31.
+func (d Date) NextDayOfMonth(day int) Date {
+	day = max(1, min(day, 31))
+	c := clampedDay(d.Year, d.Month, day)
+	if !c.After(d) {
+		next := Date{Year: d.Year, Month: d.Month + 1, Day: 1}.normalize()
+		c = clampedDay(next.Year, next.Month, day)
+	}
+	return c
+}
+
+// clampedDay returns the given day of the month, or the last day of the
+// month if it has fewer days.
+func clampedDay(year int, month time.Month, day int) Date {
+	return Date{Year: year, Month: month, Day: min(day, lastOfMonth(year, month).Day)}
+}
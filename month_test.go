@@ -0,0 +1,179 @@
+package bigqueryGoDate
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestStartAndEndOfMonth(t *testing.T) {
+	// Days in each month of a common year (2023) and a leap year (2024).
+	common := []int{31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+	leap := []int{31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+	for _, tc := range []struct {
+		year int
+		days []int
+	}{
+		{2023, common},
+		{2024, leap},
+		{1900, common}, // divisible by 100 but not 400
+		{2000, leap},   // divisible by 400
+	} {
+		for i, n := range tc.days {
+			m := time.Month(i + 1)
+			mid := Date{tc.year, m, 15}
+			first, last := Date{tc.year, m, 1}, Date{tc.year, m, n}
+			if got := mid.StartOfMonth(); got != first {
+				t.Errorf("%v.StartOfMonth() = %v, want %v", mid, got, first)
+			}
+			if got := mid.EndOfMonth(); got != last {
+				t.Errorf("%v.EndOfMonth() = %v, want %v", mid, got, last)
+			}
+		}
+	}
+}
+
+func TestIsStartAndEndOfMonth(t *testing.T) {
+	// Days in each month of a common year (2023) and a leap year (2024).
+	common := []int{31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+	leap := []int{31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+	for _, tc := range []struct {
+		year int
+		days []int
+	}{
+		{2023, common},
+		{2024, leap},
+		{1900, common}, // divisible by 100 but not 400
+		{2000, leap},   // divisible by 400
+	} {
+		for i, n := range tc.days {
+			m := time.Month(i + 1)
+			first, mid, last := Date{tc.year, m, 1}, Date{tc.year, m, 15}, Date{tc.year, m, n}
+			if !first.IsStartOfMonth() || mid.IsStartOfMonth() || last.IsStartOfMonth() {
+				t.Errorf("IsStartOfMonth wrong in %d-%02d", tc.year, m)
+			}
+			if !last.IsEndOfMonth() || mid.IsEndOfMonth() || first.IsEndOfMonth() {
+				t.Errorf("IsEndOfMonth wrong in %d-%02d", tc.year, m)
+			}
+		}
+	}
+	// February 28 ends the month only in common years.
+	if (Date{2024, 2, 28}).IsEndOfMonth() {
+		t.Error("2024-02-28.IsEndOfMonth() = true, want false")
+	}
+	if !(Date{2023, 2, 28}).IsEndOfMonth() {
+		t.Error("2023-02-28.IsEndOfMonth() = false, want true")
+	}
+}
+
+func TestAddMonths(t *testing.T) {
+	for _, tc := range []struct {
+		d    Date
+		n    int
+		want Date
+	}{
+		{Date{2023, 5, 15}, 1, Date{2023, 6, 15}},
+		{Date{2023, 5, 15}, 0, Date{2023, 5, 15}},
+		// Clamping to the end of a shorter month, in leap and common years.
+		{Date{2024, 1, 31}, 1, Date{2024, 2, 29}},
+		{Date{2023, 1, 31}, 1, Date{2023, 2, 28}},
+		{Date{2023, 3, 31}, 1, Date{2023, 4, 30}},
+		{Date{2023, 3, 31}, -1, Date{2023, 2, 28}},
+		{Date{2024, 3, 30}, -1, Date{2024, 2, 29}},
+		// December to January rolls the year over, in both directions.
+		{Date{2023, 12, 15}, 1, Date{2024, 1, 15}},
+		{Date{2023, 12, 31}, 2, Date{2024, 2, 29}},
+		{Date{2024, 1, 15}, -1, Date{2023, 12, 15}},
+		{Date{2023, 11, 30}, 14, Date{2025, 1, 30}},
+		{Date{2023, 5, 31}, -17, Date{2021, 12, 31}},
+		{Date{2023, 5, 31}, -27, Date{2021, 2, 28}},
+	} {
+		if got := tc.d.AddMonths(tc.n); got != tc.want {
+			t.Errorf("%v.AddMonths(%d) = %v, want %v", tc.d, tc.n, got, tc.want)
+		}
+	}
+}
+
+func TestAddYears(t *testing.T) {
+	for _, tc := range []struct {
+		d    Date
+		n    int
+		want Date
+	}{
+		{Date{2024, 2, 29}, 1, Date{2025, 2, 28}},
+		{Date{2024, 2, 29}, 4, Date{2028, 2, 29}},
+		{Date{2024, 2, 29}, -1, Date{2023, 2, 28}},
+		{Date{2024, 2, 29}, -4, Date{2020, 2, 29}},
+		{Date{2000, 2, 29}, 100, Date{2100, 2, 28}},
+		{Date{2023, 12, 31}, -3, Date{2020, 12, 31}},
+	} {
+		if got := tc.d.AddYears(tc.n); got != tc.want {
+			t.Errorf("%v.AddYears(%d) = %v, want %v", tc.d, tc.n, got, tc.want)
+		}
+	}
+}
+
+func TestNextDayOfMonth(t *testing.T) {
+	for _, tc := range []struct {
+		d    Date
+		day  int
+		want Date
+	}{
+		{Date{2024, 1, 10}, 15, Date{2024, 1, 15}},
+		{Date{2024, 1, 15}, 15, Date{2024, 2, 15}},
+		{Date{2024, 1, 31}, 31, Date{2024, 2, 29}},
+		{Date{2024, 2, 29}, 31, Date{2024, 3, 31}},
+		{Date{2024, 3, 31}, 31, Date{2024, 4, 30}},
+		{Date{2023, 2, 27}, 30, Date{2023, 2, 28}},
+		{Date{2023, 12, 31}, 1, Date{2024, 1, 1}},
+		{Date{2023, 12, 20}, 0, Date{2024, 1, 1}},
+		{Date{2023, 5, 1}, 40, Date{2023, 5, 31}},
+	} {
+		if got := tc.d.NextDayOfMonth(tc.day); got != tc.want {
+			t.Errorf("%v.NextDayOfMonth(%d) = %v, want %v", tc.d, tc.day, got, tc.want)
+		}
+	}
+}
+
+func TestWeekdaysInMonth(t *testing.T) {
+	for _, tc := range []struct {
+		year  int
+		month time.Month
+		w     time.Weekday
+		want  []Date
+	}{
+		{2023, time.May, time.Monday, []Date{{2023, 5, 1}, {2023, 5, 8}, {2023, 5, 15}, {2023, 5, 22}, {2023, 5, 29}}},
+		{2023, time.February, time.Sunday, []Date{{2023, 2, 5}, {2023, 2, 12}, {2023, 2, 19}, {2023, 2, 26}}},
+		{2024, time.February, time.Thursday, []Date{{2024, 2, 1}, {2024, 2, 8}, {2024, 2, 15}, {2024, 2, 22}, {2024, 2, 29}}},
+	} {
+		got := WeekdaysInMonth(tc.year, tc.month, tc.w)
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("WeekdaysInMonth(%d, %v, %v) = %v, want %v", tc.year, tc.month, tc.w, got, tc.want)
+		}
+	}
+}
+
+func TestParseYearMonth(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		want Date
+		ok   bool
+	}{
+		{"2023-05", Date{2023, 5, 1}, true},
+		{"0001-12", Date{1, 12, 1}, true},
+		{"2023-13", Date{}, false},
+		{"2023-5", Date{}, false},
+		{"2023-05-01", Date{}, false},
+		{"", Date{}, false},
+	} {
+		got, err := ParseYearMonth(tc.s)
+		if (err == nil) != tc.ok || got != tc.want {
+			t.Errorf("ParseYearMonth(%q) = %v, %v; want %v, ok=%v", tc.s, got, err, tc.want, tc.ok)
+		}
+		if tc.ok {
+			if s := got.YearMonthString(); s != tc.s {
+				t.Errorf("%v.YearMonthString() = %q, want %q", got, s, tc.s)
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package bigqueryGoDate
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// Null represents a value of type T that may be null, following the
+// conventions of sql.NullTime. T must implement driver.Valuer, and PT,
+// which is always *T, must implement sql.Scanner, as Date, Time and
+// DateTime do; both are checked at compile time.
+//
+// Unlike sql.NullTime, whose value is in the field Time, the value is
+// always in the field V, so a NullDate is written NullDate{V: d, Valid:
+// true} rather than with a Date field.
+type Null[T driver.Valuer, PT interface {
+	*T
+	sql.Scanner
+}] struct {
+	V     T
+	Valid bool // Valid is true if V is not NULL
+}
+
+// The nullable forms of the types in this package.
+type (
+	NullDate     = Null[Date, *Date]
+	NullTime     = Null[Time, *Time]
+	NullDateTime = Null[DateTime, *DateTime]
+)
+
+// Scan implements the database/sql Scanner interface. A nil value, or a
+// nil pointer at any level such as a nil *time.Time, sets Valid to false;
+// any other value is scanned into V.
+func (n *Null[T, PT]) Scan(value any) error {
+	value, isNil := unwrapScanValue(value)
+	if isNil {
+		var zero T
+		n.V, n.Valid = zero, false
+		return nil
+	}
+	err := PT(&n.V).Scan(value)
+	n.Valid = err == nil
+	return err
+}
+
+// Value implements the database/sql/driver Valuer interface. It returns
+// nil if Valid is false.
+func (n Null[T, PT]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.V.Value()
+}
+
+// MarshalJSON implements the json.Marshaler interface. An invalid value is
+// encoded as JSON null.
+func (n Null[T, PT]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.V)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. JSON null sets
+// Valid to false; any other value is decoded into V.
+func (n *Null[T, PT]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		var zero T
+		n.V, n.Valid = zero, false
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.V); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
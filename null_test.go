@@ -0,0 +1,78 @@
+package bigqueryGoDate
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+)
+
+func TestNullDateScan(t *testing.T) {
+	var (
+		nilTime    *time.Time
+		nilTimePtr **time.Time
+	)
+	for _, tc := range []struct {
+		name string
+		v    any
+		want NullDate
+	}{
+		{"nil", nil, NullDate{}},
+		{"nil *time.Time", nilTime, NullDate{}},
+		{"nil **time.Time", nilTimePtr, NullDate{}},
+		{"**time.Time to nil", &nilTime, NullDate{}},
+		{"string", "2023-05-01", NullDate{V: Date{2023, 5, 1}, Valid: true}},
+		{"time.Time", time.Date(2023, 5, 1, 13, 45, 0, 0, time.UTC), NullDate{V: Date{2023, 5, 1}, Valid: true}},
+		{"civil.Date", civil.Date{Year: 2023, Month: 5, Day: 1}, NullDate{V: Date{2023, 5, 1}, Valid: true}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			n := NullDate{V: Date{2000, 1, 1}, Valid: true}
+			if err := n.Scan(tc.v); err != nil {
+				t.Fatalf("Scan(%v) returned error: %v", tc.v, err)
+			}
+			if n != tc.want {
+				t.Errorf("Scan(%v) = %+v, want %+v", tc.v, n, tc.want)
+			}
+		})
+	}
+
+	n := NullDate{V: Date{2000, 1, 1}, Valid: true}
+	if err := n.Scan("not a date"); err == nil || n.Valid {
+		t.Errorf("Scan of a bad string = %+v, %v; want Valid false and an error", n, err)
+	}
+}
+
+func TestNullValue(t *testing.T) {
+	if v, err := (NullDate{}).Value(); v != nil || err != nil {
+		t.Errorf("invalid NullDate.Value() = %v, %v; want nil, nil", v, err)
+	}
+	if v, err := (NullDate{V: Date{2023, 5, 1}, Valid: true}).Value(); v != "2023-05-01" || err != nil {
+		t.Errorf("NullDate.Value() = %v, %v; want 2023-05-01", v, err)
+	}
+	if v, err := (NullTime{V: Time{13, 45, 0, 0}, Valid: true}).Value(); v != "13:45:00" || err != nil {
+		t.Errorf("NullTime.Value() = %v, %v; want 13:45:00", v, err)
+	}
+	if v, err := (NullDateTime{}).Value(); v != nil || err != nil {
+		t.Errorf("invalid NullDateTime.Value() = %v, %v; want nil, nil", v, err)
+	}
+}
+
+func TestNullJSON(t *testing.T) {
+	for _, tc := range []struct {
+		n    NullDate
+		json string
+	}{
+		{NullDate{}, `null`},
+		{NullDate{V: Date{2023, 5, 1}, Valid: true}, `"2023-05-01"`},
+	} {
+		b, err := json.Marshal(tc.n)
+		if err != nil || string(b) != tc.json {
+			t.Errorf("Marshal(%+v) = %s, %v; want %s", tc.n, b, err, tc.json)
+		}
+		got := NullDate{V: Date{2000, 1, 1}, Valid: true}
+		if err := json.Unmarshal([]byte(tc.json), &got); err != nil || got != tc.n {
+			t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", tc.json, got, err, tc.n)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package bigqueryGoDate
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseDateFormat parses s using the Go reference layout and returns the
+// date it represents. Any time or zone fields in the layout are parsed
+// and then ignored.
+func ParseDateFormat(s, layout string) (Date, error) {
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+// anyDateLayouts are the layouts tried, in order, by ParseDateAny.
+var anyDateLayouts = []string{
+	"2006-01-02",
+	"2006/01/02",
+	"20060102",
+	"01/02/2006",
+}
+
+// ParseDateAny parses s using the first of the following layouts that
+// matches:
+//
+//	2006-01-02
+//	2006/01/02
+//	20060102
+//	01/02/2006 (month first)
+//
+// If none matches, the error lists the layouts that were tried. ParseDate
+// remains strict and accepts only the first form.
+func ParseDateAny(s string) (Date, error) {
+	for _, layout := range anyDateLayouts {
+		if d, err := ParseDateFormat(s, layout); err == nil {
+			return d, nil
+		}
+	}
+	return Date{}, fmt.Errorf("cannot parse %q as a date (tried %s)", s, strings.Join(anyDateLayouts, "; "))
+}
+
+// textDateLayouts are the layouts accepted by ParseDateText.
+var textDateLayouts = []string{
+	"Mon Jan 2 2006",
+	"Jan 2 2006",
+	"Monday, January 2, 2006",
+	"January 2, 2006",
+}
+
+// ParseDateText parses a date written out with English month names, in one
+// of the forms
+//
+//	Mon Jan 2 2006
+//	Monday, January 2, 2006
+//
+// where the weekday prefix is optional and names are matched without
+// regard to case. The weekday, if present, is not checked against the
+// date.
+func ParseDateText(s string) (Date, error) {
+	for _, layout := range textDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return DateOf(t), nil
+		}
+	}
+	return Date{}, fmt.Errorf("cannot parse %q as a textual date (tried %s)", s, strings.Join(textDateLayouts, "; "))
+}
+
+// ParseDateTwoDigitYear parses a date with a two-digit year in one of the
+// forms
+//
+//	YY-MM-DD  (23-05-01)
+//	MMDDYY    (050123)
+//
+// A two-digit year yy below pivot is taken as 20yy, and any other as 19yy,
+// so with pivot 50, "49" is 2049 and "50" is 1950. pivot must be between 0
+// and 100; 0 puts every year in the 1900s and 100 every year in the 2000s.
+func ParseDateTwoDigitYear(s string, pivot int) (Date, error) {
+	if pivot < 0 || pivot > 100 {
+		return Date{}, fmt.Errorf("invalid two-digit year pivot %d: want 0-100", pivot)
+	}
+	var yy, mm, dd string
+	switch {
+	case len(s) == 8 && s[2] == '-' && s[5] == '-':
+		yy, mm, dd = s[0:2], s[3:5], s[6:8]
+	case len(s) == 6:
+		mm, dd, yy = s[0:2], s[2:4], s[4:6]
+	default:
+		return Date{}, fmt.Errorf("cannot parse %q as a date with a two-digit year", s)
+	}
+	y, ok1 := atoi(yy)
+	m, ok2 := atoi(mm)
+	d, ok3 := atoi(dd)
+	if !ok1 || !ok2 || !ok3 {
+		return Date{}, fmt.Errorf("cannot parse %q as a date with a two-digit year", s)
+	}
+	if y < pivot {
+		y += 2000
+	} else {
+		y += 1900
+	}
+	return NewDateStrict(y, time.Month(m), d)
+}
+
+// ParseDateLocalized parses a numeric date of the form DD/MM/YYYY if
+// dayFirst is true, or MM/DD/YYYY if it is false. The separator may be '/'
+// or '-', but must be the same in both places, and the day and month may
+// have one or two digits. Since the order is given rather than guessed, a
+// value that is impossible in that order, such as month 13, is an error
+// even if it would be valid in the other.
+func ParseDateLocalized(s string, dayFirst bool) (Date, error) {
+	sep := "/"
+	if strings.Count(s, "-") == 2 {
+		sep = "-"
+	}
+	parts := strings.Split(s, sep)
+	if len(parts) != 3 || len(parts[0]) < 1 || len(parts[0]) > 2 || len(parts[1]) < 1 || len(parts[1]) > 2 || len(parts[2]) != 4 {
+		return Date{}, fmt.Errorf("cannot parse %q as a numeric date", s)
+	}
+	first, ok1 := atoi(parts[0])
+	second, ok2 := atoi(parts[1])
+	y, ok3 := atoi(parts[2])
+	if !ok1 || !ok2 || !ok3 {
+		return Date{}, fmt.Errorf("cannot parse %q as a numeric date", s)
+	}
+	m, d, order := first, second, "MM/DD/YYYY"
+	if dayFirst {
+		m, d, order = second, first, "DD/MM/YYYY"
+	}
+	if m < 1 || m > 12 {
+		return Date{}, fmt.Errorf("cannot parse %q as %s: month %d out of range", s, order, m)
+	}
+	return NewDateStrict(y, time.Month(m), d)
+}
@@ -0,0 +1,285 @@
+package bigqueryGoDate
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// The fuzz targets below check that the parsers never panic, that whatever
+// they accept is a valid value, and that formatting an accepted value and
+// parsing it again gives the same value back. Run one with, for example,
+//
+//	go test -fuzz=FuzzParseDate -fuzztime=60s
+
+func FuzzParseDate(f *testing.F) {
+	for _, s := range []string{
+		"2023-05-01", "0001-01-01", "9999-12-31", "2024-02-29", "2023-02-29",
+		"2023-13-01", "0000-00-00", "2023-5-1", "+023-05-01", "", "2023-05-01T00:00:00",
+	} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		d, err := ParseDate(s)
+		want, wantErr := parseDateSlow(s)
+		if d != want || (err == nil) != (wantErr == nil) {
+			t.Fatalf("ParseDate(%q) = %v, %v; without the fast path %v, %v", s, d, err, want, wantErr)
+		}
+		if err != nil {
+			return
+		}
+		if !d.IsValid() {
+			t.Fatalf("ParseDate(%q) accepted invalid date %v", s, d)
+		}
+		if d2, err := ParseDate(d.String()); err != nil || d2 != d {
+			t.Fatalf("ParseDate(%q) = %v, but ParseDate(%q) = %v, %v", s, d, d.String(), d2, err)
+		}
+	})
+}
+
+func FuzzParseTime(f *testing.F) {
+	for _, s := range []string{
+		"13:45:00", "00:00:00", "23:59:59.999999999", "13:45:00.5", "13:45",
+		"1:02:03", "24:00:00", "13:60:00", "13:45:00,5", "13:45:00.", "",
+	} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		tm, err := ParseTime(s)
+		var p Parser
+		if ptm, perr := p.ParseTime(s); ptm != tm || (perr == nil) != (err == nil) {
+			t.Fatalf("Parser.ParseTime(%q) = %v, %v; ParseTime gives %v, %v", s, ptm, perr, tm, err)
+		}
+		if err != nil {
+			return
+		}
+		if !tm.IsValid() {
+			t.Fatalf("ParseTime(%q) accepted invalid time %v", s, tm)
+		}
+		if tm2, err := ParseTime(tm.String()); err != nil || tm2 != tm {
+			t.Fatalf("ParseTime(%q) = %v, but ParseTime(%q) = %v, %v", s, tm, tm.String(), tm2, err)
+		}
+	})
+}
+
+func FuzzParseDateTime(f *testing.F) {
+	for _, s := range []string{
+		"2023-05-01T13:45:00", "2023-05-01t13:45:00.5", "2023-05-01 13:45:00",
+		"2023-05-01T13:45", "2023-05-01 13:45", "9999-12-31T23:59:59.999999999",
+		"2023-02-30T13:45:00", "2023-05-01T24:00:00", "2023-05-01X13:45:00", "",
+	} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		dt, err := ParseDateTime(s)
+		var p Parser
+		if pdt, perr := p.ParseDateTime(s); pdt != dt || (perr == nil) != (err == nil) {
+			t.Fatalf("Parser.ParseDateTime(%q) = %v, %v; ParseDateTime gives %v, %v", s, pdt, perr, dt, err)
+		}
+		if err != nil {
+			return
+		}
+		if !dt.IsValid() {
+			t.Fatalf("ParseDateTime(%q) accepted invalid datetime %v", s, dt)
+		}
+		if dt2, err := ParseDateTime(dt.String()); err != nil || dt2 != dt {
+			t.Fatalf("ParseDateTime(%q) = %v, but ParseDateTime(%q) = %v, %v", s, dt, dt.String(), dt2, err)
+		}
+	})
+}
+
+func FuzzParseTimeCompact(f *testing.F) {
+	for _, s := range []string{"134500", "134500.25", "235959.999999999", "240000", "1345", "134500.", "134500.1234567890", ""} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		tm, err := ParseTimeCompact(s)
+		if err != nil {
+			return
+		}
+		if !tm.IsValid() {
+			t.Fatalf("ParseTimeCompact(%q) accepted invalid time %v", s, tm)
+		}
+		if tm2, err := ParseTimeCompact(tm.StringCompact()); err != nil || tm2 != tm {
+			t.Fatalf("ParseTimeCompact(%q) = %v, but ParseTimeCompact(%q) = %v, %v", s, tm, tm.StringCompact(), tm2, err)
+		}
+	})
+}
+
+func FuzzParseISOWeekDate(f *testing.F) {
+	for _, s := range []string{"2023-W18-1", "2020-W53-7", "2021-W53-1", "2023-W00-1", "2023-W18-8", "0000-W01-1", "2023W181", ""} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		d, err := ParseISOWeekDate(s)
+		if err != nil {
+			return
+		}
+		if !d.IsValid() {
+			t.Fatalf("ParseISOWeekDate(%q) returned invalid date %v", s, d)
+		}
+		if got := d.ISOWeekDateString(); got != s {
+			t.Fatalf("ParseISOWeekDate(%q) = %v, which formats as %q", s, d, got)
+		}
+	})
+}
+
+func FuzzParseDateLocalized(f *testing.F) {
+	for _, s := range []string{"01/05/2023", "1-5-2023", "13/05/2023", "05/13/2023", "31/02/2023", "01/05-2023", "1//2023", ""} {
+		f.Add(s, true)
+		f.Add(s, false)
+	}
+	f.Fuzz(func(t *testing.T, s string, dayFirst bool) {
+		d, err := ParseDateLocalized(s, dayFirst)
+		if err != nil {
+			return
+		}
+		if !d.IsValid() {
+			t.Fatalf("ParseDateLocalized(%q, %v) accepted invalid date %v", s, dayFirst, d)
+		}
+		formatted := fmt.Sprintf("%02d/%02d/%04d", int(d.Month), d.Day, d.Year)
+		if dayFirst {
+			formatted = fmt.Sprintf("%02d/%02d/%04d", d.Day, int(d.Month), d.Year)
+		}
+		if d2, err := ParseDateLocalized(formatted, dayFirst); err != nil || d2 != d {
+			t.Fatalf("ParseDateLocalized(%q, %v) = %v, but %q gives %v, %v", s, dayFirst, d, formatted, d2, err)
+		}
+	})
+}
+
+func FuzzParseDateTwoDigitYear(f *testing.F) {
+	for _, s := range []string{"23-05-01", "050123", "99-12-31", "00-01-01", "023101", "13-13-01", "2305-01", ""} {
+		f.Add(s, 50)
+		f.Add(s, 0)
+		f.Add(s, 100)
+	}
+	f.Fuzz(func(t *testing.T, s string, pivot int) {
+		d, err := ParseDateTwoDigitYear(s, pivot)
+		if err != nil {
+			return
+		}
+		if !d.IsValid() || d.Year < 1900 || d.Year > 2099 {
+			t.Fatalf("ParseDateTwoDigitYear(%q, %d) = %v, want a valid date in 1900-2099", s, pivot, d)
+		}
+		if (d.Year >= 2000) != (d.Year%100 < pivot) {
+			t.Fatalf("ParseDateTwoDigitYear(%q, %d) = %v, on the wrong side of the pivot", s, pivot, d)
+		}
+		formatted := fmt.Sprintf("%02d-%02d-%02d", d.Year%100, int(d.Month), d.Day)
+		if d2, err := ParseDateTwoDigitYear(formatted, pivot); err != nil || d2 != d {
+			t.Fatalf("ParseDateTwoDigitYear(%q, %d) = %v, but %q gives %v, %v", s, pivot, d, formatted, d2, err)
+		}
+	})
+}
+
+func TestParseDateFormat(t *testing.T) {
+	for _, tc := range []struct {
+		s, layout string
+		want      Date
+		ok        bool
+	}{
+		{"01.05.2023", "02.01.2006", Date{2023, 5, 1}, true},
+		{"May 1, 2023", "Jan 2, 2006", Date{2023, 5, 1}, true},
+		// Time and zone fields are parsed and then ignored.
+		{"2023-05-01 23:30 -0700", "2006-01-02 15:04 -0700", Date{2023, 5, 1}, true},
+		{"31.02.2023", "02.01.2006", Date{}, false},
+		{"2023-05-01", "02.01.2006", Date{}, false},
+	} {
+		got, err := ParseDateFormat(tc.s, tc.layout)
+		if (err == nil) != tc.ok || got != tc.want {
+			t.Errorf("ParseDateFormat(%q, %q) = %v, %v; want %v, ok=%v", tc.s, tc.layout, got, err, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestParseDateAny(t *testing.T) {
+	want := Date{2023, 5, 1}
+	for _, s := range []string{"2023-05-01", "2023/05/01", "20230501", "05/01/2023"} {
+		if got, err := ParseDateAny(s); err != nil || got != want {
+			t.Errorf("ParseDateAny(%q) = %v, %v; want %v", s, got, err, want)
+		}
+	}
+	for _, s := range []string{"", "2023-02-30", "13/01/2023", "2023.05.01", "May 1, 2023"} {
+		if got, err := ParseDateAny(s); err == nil {
+			t.Errorf("ParseDateAny(%q) = %v, want an error", s, got)
+		} else if !strings.Contains(err.Error(), "01/02/2006") {
+			t.Errorf("ParseDateAny(%q) error %q does not list the layouts tried", s, err)
+		}
+	}
+}
+
+func TestParseDateText(t *testing.T) {
+	want := Date{2023, 5, 1}
+	for _, s := range []string{
+		"Mon May 1 2023", "May 1 2023", "Monday, May 1, 2023", "May 1, 2023",
+		"mon may 1 2023", "MONDAY, MAY 1, 2023",
+		"Tue May 1 2023", // the weekday is not checked
+	} {
+		if got, err := ParseDateText(s); err != nil || got != want {
+			t.Errorf("ParseDateText(%q) = %v, %v; want %v", s, got, err, want)
+		}
+	}
+	for _, s := range []string{"", "2023-05-01", "May 32 2023", "Foo 1 2023", "1 May 2023"} {
+		if got, err := ParseDateText(s); err == nil {
+			t.Errorf("ParseDateText(%q) = %v, want an error", s, got)
+		}
+	}
+}
+
+func TestParseDateTwoDigitYear(t *testing.T) {
+	for _, tc := range []struct {
+		s     string
+		pivot int
+		want  Date
+		ok    bool
+	}{
+		{"23-05-01", 50, Date{2023, 5, 1}, true},
+		{"050123", 50, Date{2023, 5, 1}, true},
+		{"49-12-31", 50, Date{2049, 12, 31}, true},
+		{"50-01-01", 50, Date{1950, 1, 1}, true},
+		{"00-01-01", 0, Date{1900, 1, 1}, true},
+		{"99-01-01", 100, Date{2099, 1, 1}, true},
+		{"00-02-29", 50, Date{2000, 2, 29}, true},
+		{"00-02-29", 0, Date{1900, 2, 29}, false}, // 1900 is not a leap year
+		{"23-13-01", 50, Date{}, false},
+		{"130123", 50, Date{}, false},
+		{"23/05/01", 50, Date{}, false},
+		{"2023-05-01", 50, Date{}, false},
+		{"23-05-01", -1, Date{}, false},
+		{"23-05-01", 101, Date{}, false},
+	} {
+		got, err := ParseDateTwoDigitYear(tc.s, tc.pivot)
+		if (err == nil) != tc.ok || (tc.ok && got != tc.want) {
+			t.Errorf("ParseDateTwoDigitYear(%q, %d) = %v, %v; want %v, ok=%v", tc.s, tc.pivot, got, err, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestParseDateLocalized(t *testing.T) {
+	for _, tc := range []struct {
+		s        string
+		dayFirst bool
+		want     Date
+		ok       bool
+	}{
+		{"01/05/2023", true, Date{2023, 5, 1}, true},
+		{"01/05/2023", false, Date{2023, 1, 5}, true},
+		{"1-5-2023", true, Date{2023, 5, 1}, true},
+		{"5/1/2023", false, Date{2023, 5, 1}, true},
+		{"31/12/2023", true, Date{2023, 12, 31}, true},
+		{"12/31/2023", false, Date{2023, 12, 31}, true},
+		// Valid only in the other order.
+		{"13/05/2023", false, Date{}, false},
+		{"05/13/2023", true, Date{}, false},
+		{"29/02/2023", true, Date{}, false},
+		{"01/05-2023", true, Date{}, false},
+		{"001/05/2023", true, Date{}, false},
+		{"01/05/23", true, Date{}, false},
+		{"a1/05/2023", true, Date{}, false},
+		{"", true, Date{}, false},
+	} {
+		got, err := ParseDateLocalized(tc.s, tc.dayFirst)
+		if (err == nil) != tc.ok || (tc.ok && got != tc.want) {
+			t.Errorf("ParseDateLocalized(%q, %v) = %v, %v; want %v, ok=%v", tc.s, tc.dayFirst, got, err, tc.want, tc.ok)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package bigqueryGoDate
+
+import (
+	"fmt"
+	"testing"
+)
+
+var parserTimeInputs = []string{
+	"00:00:00", "13:45:00", "23:59:59.999999999", "13:45:00.5", "13:45:00.123456",
+	"13:45", "1:02:03", "13:45:00,5", "13:45:00.", "13:45:00.1234567891",
+	"24:00:00", "13:60:00", "13:45:60", "13-45-00", "", "13:45:00Z", "+1:45:00",
+}
+
+var parserDateTimeInputs = []string{
+	"2023-05-01T13:45:00", "2023-05-01t13:45:00.5", "2023-05-01 13:45:00.123456",
+	"2023-05-01T13:45", "2023-05-01 13:45", "0001-01-01T00:00:00", "9999-12-31T23:59:59.999999999",
+	"2023-02-30T13:45:00", "2023-02-29 00:00:00", "2023-05-01X13:45:00", "2023-05-01T24:00:00",
+	"2023-05-01T13:45:00.", "2023-5-1T13:45:00", "", "2023-05-01T13:45:00Z",
+}
+
+func TestParserMatchesPackage(t *testing.T) {
+	var p Parser
+	for _, s := range parserTimeInputs {
+		// Twice, so the second call goes through the memo.
+		for i := 0; i < 2; i++ {
+			got, gotErr := p.ParseTime(s)
+			want, wantErr := ParseTime(s)
+			if got != want || fmt.Sprint(gotErr) != fmt.Sprint(wantErr) {
+				t.Errorf("Parser.ParseTime(%q) = %v, %v; ParseTime gives %v, %v", s, got, gotErr, want, wantErr)
+			}
+		}
+	}
+	for _, s := range parserDateTimeInputs {
+		for i := 0; i < 2; i++ {
+			got, gotErr := p.ParseDateTime(s)
+			want, wantErr := ParseDateTime(s)
+			if got != want || fmt.Sprint(gotErr) != fmt.Sprint(wantErr) {
+				t.Errorf("Parser.ParseDateTime(%q) = %v, %v; ParseDateTime gives %v, %v", s, got, gotErr, want, wantErr)
+			}
+		}
+	}
+	for _, s := range []string{"2023-05-01", "2023-02-30", "20230501", ""} {
+		for i := 0; i < 2; i++ {
+			got, gotErr := p.ParseDate(s)
+			want, wantErr := ParseDate(s)
+			if got != want || fmt.Sprint(gotErr) != fmt.Sprint(wantErr) {
+				t.Errorf("Parser.ParseDate(%q) = %v, %v; ParseDate gives %v, %v", s, got, gotErr, want, wantErr)
+			}
+		}
+	}
+}
+
+// benchTimes and benchDateTimes hold distinct values, so the Parser memo
+// never hits while cycling through them.
+var (
+	benchTimes     = make([]string, 1000)
+	benchDateTimes = make([]string, 1000)
+)
+
+func init() {
+	for i := range benchTimes {
+		benchTimes[i] = fmt.Sprintf("%02d:%02d:%02d.%06d", i%24, i%60, i*7%60, i*997)
+		benchDateTimes[i] = fmt.Sprintf("2023-%02d-%02dT%s", i%12+1, i%28+1, benchTimes[i])
+	}
+}
+
+func BenchmarkParseTime(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseTime(benchTimes[i%len(benchTimes)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParserParseTime(b *testing.B) {
+	b.ReportAllocs()
+	var p Parser
+	for i := 0; i < b.N; i++ {
+		if _, err := p.ParseTime(benchTimes[i%len(benchTimes)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseDateTime(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseDateTime(benchDateTimes[i%len(benchDateTimes)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParserParseDateTime(b *testing.B) {
+	b.ReportAllocs()
+	var p Parser
+	for i := 0; i < b.N; i++ {
+		if _, err := p.ParseDateTime(benchDateTimes[i%len(benchDateTimes)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkParserParseDateTimeRepeated measures a column where each value
+// repeats on consecutive rows, as with a load date, so the memo hits.
+func BenchmarkParserParseDateTimeRepeated(b *testing.B) {
+	b.ReportAllocs()
+	var p Parser
+	for i := 0; i < b.N; i++ {
+		if _, err := p.ParseDateTime(benchDateTimes[i/100%len(benchDateTimes)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParserParseDate(b *testing.B) {
+	b.ReportAllocs()
+	var p Parser
+	for i := 0; i < b.N; i++ {
+		if _, err := p.ParseDate(benchDateTimes[i%len(benchDateTimes)][:10]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
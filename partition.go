@@ -0,0 +1,17 @@
+package bigqueryGoDate
+
+import "fmt"
+
+// PartitionSuffix returns the partition decorator for d in a daily
+// partitioned BigQuery table, in the form YYYYMMDD, so that
+// "mytable$"+d.PartitionSuffix() names that day's partition.
+func (d Date) PartitionSuffix() string {
+	return d.StringCompact()
+}
+
+// HourPartitionSuffix returns the partition decorator for the hour
+// containing dt in an hourly partitioned BigQuery table, in the form
+// YYYYMMDDHH. Minutes, seconds and fractions are ignored.
+func (dt DateTime) HourPartitionSuffix() string {
+	return fmt.Sprintf("%s%02d", dt.Date.StringCompact(), dt.Time.Hour)
+}
@@ -0,0 +1,33 @@
+package bigqueryGoDate
+
+import "testing"
+
+func TestPartitionSuffix(t *testing.T) {
+	for _, tc := range []struct {
+		d    Date
+		want string
+	}{
+		{Date{2023, 5, 1}, "20230501"},
+		{Date{1, 1, 1}, "00010101"},
+		{Date{9999, 12, 31}, "99991231"},
+	} {
+		if got := tc.d.PartitionSuffix(); got != tc.want {
+			t.Errorf("%v.PartitionSuffix() = %q, want %q", tc.d, got, tc.want)
+		}
+	}
+}
+
+func TestHourPartitionSuffix(t *testing.T) {
+	for _, tc := range []struct {
+		dt   DateTime
+		want string
+	}{
+		{DateTime{Date{2023, 5, 1}, Time{}}, "2023050100"},
+		{DateTime{Date{2023, 5, 1}, Time{9, 59, 59, 999999999}}, "2023050109"},
+		{DateTime{Date{2023, 12, 31}, Time{Hour: 23, Minute: 1}}, "2023123123"},
+	} {
+		if got := tc.dt.HourPartitionSuffix(); got != tc.want {
+			t.Errorf("%v.HourPartitionSuffix() = %q, want %q", tc.dt, got, tc.want)
+		}
+	}
+}
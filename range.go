@@ -0,0 +1,146 @@
+package bigqueryGoDate
+
+import (
+	"fmt"
+	"iter"
+	"time"
+)
+
+// A DateRange is the range of dates from Start to End, inclusive of both.
+type DateRange struct {
+	Start Date
+	End   Date
+}
+
+// Step returns an iterator over the dates of r spaced days apart, starting
+// at r.Start. The last date yielded is the last one that is not after
+// r.End, so a final partial step is dropped. Nothing is yielded if r.End is
+// before r.Start. Step panics if days is not positive.
+func (r DateRange) Step(days int) iter.Seq[Date] {
+	if days <= 0 {
+		panic("bigqueryGoDate: non-positive DateRange step")
+	}
+	return func(yield func(Date) bool) {
+		for d := r.Start; !d.After(r.End); d = d.AddDays(days) {
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// InstantsIn returns the instant of local midnight in loc for each date of
+// r, in order. The dates are stepped on the civil calendar and each one is
+// converted separately with Date.In, so days that are 23 or 25 hours long
+// because of a daylight saving transition are neither skipped nor repeated,
+// as they can be when adding 24 hours to an instant. Where midnight does not
+// exist in loc, the instant is the one time.Date chooses, which is on the
+// same day. It returns nil if r.End is before r.Start. Like Date.In, it
+// panics if loc is nil.
+func (r DateRange) InstantsIn(loc *time.Location) []time.Time {
+	var instants []time.Time
+	for d := r.Start; !d.After(r.End); d = d.AddDays(1) {
+		instants = append(instants, d.In(loc))
+	}
+	return instants
+}
+
+// FractionElapsed returns how far through r the date asOf is, as
+// asOf.DaysSince(r.Start) divided by r.End.DaysSince(r.Start), clamped to
+// the range [0, 1]: it is 0 on or before r.Start and 1 on or after r.End.
+// If r spans no days (r.End is not after r.Start), the result is 0 before
+// r.Start and 1 otherwise.
+func (r DateRange) FractionElapsed(asOf Date) float64 {
+	if asOf.Before(r.Start) {
+		return 0
+	}
+	total := r.End.DaysSince(r.Start)
+	elapsed := asOf.DaysSince(r.Start)
+	if total <= 0 || elapsed >= total {
+		return 1
+	}
+	return float64(elapsed) / float64(total)
+}
+
+// EveryWeekday returns every date of r that falls on weekday w, in
+// ascending order, or nil if there are none.
+func (r DateRange) EveryWeekday(w time.Weekday) []Date {
+	var dates []Date
+	for d := onOrAfterWeekday(r.Start, w); !d.After(r.End); d = d.AddDays(7) {
+		dates = append(dates, d)
+	}
+	return dates
+}
+
+// QuarterRange returns the range covering the given quarter of year, from
+// the first day of its first month to the last day of its third month.
+// quarter must be between 1 and 4.
+func QuarterRange(year, quarter int) (DateRange, error) {
+	if quarter < 1 || quarter > 4 {
+		return DateRange{}, fmt.Errorf("invalid quarter %d: want 1-4", quarter)
+	}
+	first := time.Month(3*(quarter-1) + 1)
+	return DateRange{
+		Start: Date{Year: year, Month: first, Day: 1},
+		End:   lastOfMonth(year, first+2),
+	}, nil
+}
+
+// QuarterStarts returns the first days of quarters (January 1, April 1,
+// July 1 and October 1) that fall within the inclusive range from start to
+// end, in ascending order. start itself is included if it is the first day
+// of a quarter, as is end. It returns nil if end is before start.
+func QuarterStarts(start, end Date) []Date {
+	q := Date{Year: start.Year, Month: time.Month(3*(start.Quarter()-1) + 1), Day: 1}
+	if q.Before(start) {
+		q = Date{Year: q.Year, Month: q.Month + 3, Day: 1}.normalize()
+	}
+	var dates []Date
+	for !q.After(end) {
+		dates = append(dates, q)
+		q = Date{Year: q.Year, Month: q.Month + 3, Day: 1}.normalize()
+	}
+	return dates
+}
+
+// A DateTimeRange is the half-open interval of datetimes [Start, End):
+// it includes Start but not End. A range whose End is not after Start is
+// empty.
+type DateTimeRange struct {
+	Start DateTime
+	End   DateTime
+}
+
+// OverlapDuration returns the length of the intersection of r and other,
+// or zero if they do not overlap. Because both ranges are half-open, ranges
+// that only touch at an endpoint do not overlap, and an empty range overlaps
+// nothing. Datetimes are interpreted in UTC.
+func (r DateTimeRange) OverlapDuration(other DateTimeRange) time.Duration {
+	start, end := r.Start, r.End
+	if other.Start.After(start) {
+		start = other.Start
+	}
+	if other.End.Before(end) {
+		end = other.End
+	}
+	if !end.After(start) {
+		return 0
+	}
+	return end.Sub(start)
+}
+
+// DatesFrom returns |n| consecutive dates beginning with start. If n is
+// positive the dates ascend (start, start+1, ..., start+(n-1)); if n is
+// negative they descend (start, start-1, ..., start-(|n|-1)). If n is zero
+// the result is an empty, non-nil slice.
+func DatesFrom(start Date, n int) []Date {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	dates := make([]Date, n)
+	for i := range dates {
+		dates[i] = start.AddDays(i * step)
+	}
+	return dates
+}
@@ -0,0 +1,208 @@
+package bigqueryGoDate
+
+import (
+	"slices"
+	"testing"
+	"time"
+	_ "time/tzdata" // for America/New_York in TestInstantsInDST
+)
+
+func TestDateRangeStep(t *testing.T) {
+	r := DateRange{Date{2023, 1, 1}, Date{2023, 1, 10}}
+	for _, tc := range []struct {
+		days int
+		want []Date
+	}{
+		{1, DatesFrom(Date{2023, 1, 1}, 10)},
+		{3, []Date{{2023, 1, 1}, {2023, 1, 4}, {2023, 1, 7}, {2023, 1, 10}}},
+		{4, []Date{{2023, 1, 1}, {2023, 1, 5}, {2023, 1, 9}}},
+		{10, []Date{{2023, 1, 1}}},
+	} {
+		if got := slices.Collect(r.Step(tc.days)); !slices.Equal(got, tc.want) {
+			t.Errorf("Step(%d) = %v, want %v", tc.days, got, tc.want)
+		}
+	}
+
+	reversed := DateRange{r.End, r.Start}
+	if got := slices.Collect(reversed.Step(1)); len(got) != 0 {
+		t.Errorf("Step over a reversed range = %v, want nothing", got)
+	}
+
+	// Stopping early ends the iteration.
+	var got []Date
+	for d := range r.Step(2) {
+		if len(got) == 2 {
+			break
+		}
+		got = append(got, d)
+	}
+	if want := []Date{{2023, 1, 1}, {2023, 1, 3}}; !slices.Equal(got, want) {
+		t.Errorf("Step(2) stopped after two = %v, want %v", got, want)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Step(0) did not panic")
+		}
+	}()
+	r.Step(0)
+}
+
+func TestInstantsInDST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2023-03-12 is 23 hours long in New York, and 2023-11-05 is 25.
+	for _, tc := range []struct {
+		r       DateRange
+		lengths []time.Duration
+	}{
+		{DateRange{Date{2023, 3, 11}, Date{2023, 3, 14}}, []time.Duration{24 * time.Hour, 23 * time.Hour, 24 * time.Hour}},
+		{DateRange{Date{2023, 11, 4}, Date{2023, 11, 7}}, []time.Duration{24 * time.Hour, 25 * time.Hour, 24 * time.Hour}},
+	} {
+		instants := tc.r.InstantsIn(ny)
+		if len(instants) != len(tc.lengths)+1 {
+			t.Fatalf("InstantsIn(%v) returned %d instants, want %d", tc.r, len(instants), len(tc.lengths)+1)
+		}
+		for i, in := range instants {
+			want := tc.r.Start.AddDays(i)
+			if DateOf(in) != want || TimeOf(in) != (Time{}) {
+				t.Errorf("instant %d = %v, want midnight on %v", i, in, want)
+			}
+		}
+		for i, want := range tc.lengths {
+			if got := instants[i+1].Sub(instants[i]); got != want {
+				t.Errorf("day %v is %v long, want %v", instants[i], got, want)
+			}
+		}
+	}
+	if got := (DateRange{Date{2023, 1, 2}, Date{2023, 1, 1}}).InstantsIn(ny); got != nil {
+		t.Errorf("InstantsIn over a reversed range = %v, want nil", got)
+	}
+}
+
+func TestFractionElapsed(t *testing.T) {
+	r := DateRange{Date{2023, 1, 1}, Date{2023, 1, 11}}
+	point := DateRange{Date{2023, 1, 1}, Date{2023, 1, 1}}
+	for _, tc := range []struct {
+		r    DateRange
+		asOf Date
+		want float64
+	}{
+		{r, Date{2022, 12, 31}, 0},
+		{r, Date{2023, 1, 1}, 0},
+		{r, Date{2023, 1, 2}, 0.1},
+		{r, Date{2023, 1, 6}, 0.5},
+		{r, Date{2023, 1, 11}, 1},
+		{r, Date{2024, 1, 1}, 1},
+		{point, Date{2022, 12, 31}, 0},
+		{point, Date{2023, 1, 1}, 1},
+		{point, Date{2023, 1, 2}, 1},
+	} {
+		if got := tc.r.FractionElapsed(tc.asOf); got != tc.want {
+			t.Errorf("%v.FractionElapsed(%v) = %v, want %v", tc.r, tc.asOf, got, tc.want)
+		}
+	}
+}
+
+func TestEveryWeekday(t *testing.T) {
+	for _, tc := range []struct {
+		r    DateRange
+		w    time.Weekday
+		want []Date
+	}{
+		{DateRange{Date{2023, 5, 1}, Date{2023, 5, 31}}, time.Monday, []Date{{2023, 5, 1}, {2023, 5, 8}, {2023, 5, 15}, {2023, 5, 22}, {2023, 5, 29}}},
+		{DateRange{Date{2023, 5, 2}, Date{2023, 5, 15}}, time.Monday, []Date{{2023, 5, 8}, {2023, 5, 15}}},
+		{DateRange{Date{2023, 12, 28}, Date{2024, 1, 10}}, time.Sunday, []Date{{2023, 12, 31}, {2024, 1, 7}}},
+		{DateRange{Date{2023, 5, 2}, Date{2023, 5, 6}}, time.Monday, nil},
+	} {
+		if got := tc.r.EveryWeekday(tc.w); !slices.Equal(got, tc.want) {
+			t.Errorf("%v.EveryWeekday(%v) = %v, want %v", tc.r, tc.w, got, tc.want)
+		}
+	}
+}
+
+func TestQuarterRange(t *testing.T) {
+	for _, tc := range []struct {
+		year, quarter int
+		want          DateRange
+	}{
+		{2024, 1, DateRange{Date{2024, 1, 1}, Date{2024, 3, 31}}},
+		{2023, 2, DateRange{Date{2023, 4, 1}, Date{2023, 6, 30}}},
+		{2023, 3, DateRange{Date{2023, 7, 1}, Date{2023, 9, 30}}},
+		{2023, 4, DateRange{Date{2023, 10, 1}, Date{2023, 12, 31}}},
+	} {
+		if got, err := QuarterRange(tc.year, tc.quarter); err != nil || got != tc.want {
+			t.Errorf("QuarterRange(%d, %d) = %v, %v; want %v", tc.year, tc.quarter, got, err, tc.want)
+		}
+	}
+	for _, q := range []int{0, 5, -1} {
+		if _, err := QuarterRange(2023, q); err == nil {
+			t.Errorf("QuarterRange(2023, %d) succeeded, want an error", q)
+		}
+	}
+}
+
+func TestQuarterStarts(t *testing.T) {
+	for _, tc := range []struct {
+		start, end Date
+		want       []Date
+	}{
+		{Date{2023, 2, 15}, Date{2024, 1, 1}, []Date{{2023, 4, 1}, {2023, 7, 1}, {2023, 10, 1}, {2024, 1, 1}}},
+		{Date{2023, 4, 1}, Date{2023, 6, 30}, []Date{{2023, 4, 1}}},
+		{Date{2023, 4, 2}, Date{2023, 6, 30}, nil},
+		{Date{2023, 12, 31}, Date{2024, 4, 1}, []Date{{2024, 1, 1}, {2024, 4, 1}}},
+		{Date{2024, 1, 1}, Date{2023, 1, 1}, nil},
+	} {
+		if got := QuarterStarts(tc.start, tc.end); !slices.Equal(got, tc.want) {
+			t.Errorf("QuarterStarts(%v, %v) = %v, want %v", tc.start, tc.end, got, tc.want)
+		}
+	}
+}
+
+func TestDatesFrom(t *testing.T) {
+	for _, tc := range []struct {
+		start Date
+		n     int
+		want  []Date
+	}{
+		{Date{2023, 12, 30}, 3, []Date{{2023, 12, 30}, {2023, 12, 31}, {2024, 1, 1}}},
+		{Date{2024, 3, 1}, -3, []Date{{2024, 3, 1}, {2024, 2, 29}, {2024, 2, 28}}},
+		{Date{2024, 3, 1}, 1, []Date{{2024, 3, 1}}},
+	} {
+		if got := DatesFrom(tc.start, tc.n); !slices.Equal(got, tc.want) {
+			t.Errorf("DatesFrom(%v, %d) = %v, want %v", tc.start, tc.n, got, tc.want)
+		}
+	}
+	if got := DatesFrom(Date{2024, 3, 1}, 0); got == nil || len(got) != 0 {
+		t.Errorf("DatesFrom(_, 0) = %#v, want an empty non-nil slice", got)
+	}
+}
+
+func TestOverlapDuration(t *testing.T) {
+	at := func(h, m int) DateTime { return DateTime{Date{2023, 5, 1}, Time{Hour: h, Minute: m}} }
+	r := DateTimeRange{at(9, 0), at(17, 0)}
+	for _, tc := range []struct {
+		other DateTimeRange
+		want  time.Duration
+	}{
+		{DateTimeRange{at(12, 0), at(18, 0)}, 5 * time.Hour},
+		{DateTimeRange{at(8, 0), at(10, 30)}, 90 * time.Minute},
+		{DateTimeRange{at(10, 0), at(11, 0)}, time.Hour},
+		{DateTimeRange{at(8, 0), at(18, 0)}, 8 * time.Hour},
+		{DateTimeRange{at(17, 0), at(18, 0)}, 0}, // touching at End
+		{DateTimeRange{at(7, 0), at(9, 0)}, 0},   // touching at Start
+		{DateTimeRange{at(18, 0), at(19, 0)}, 0},
+		{DateTimeRange{at(12, 0), at(12, 0)}, 0}, // empty
+		{DateTimeRange{at(13, 0), at(12, 0)}, 0}, // reversed
+		{DateTimeRange{DateTime{Date{2023, 4, 30}, Time{Hour: 23}}, DateTime{Date{2023, 5, 1}, Time{Hour: 9, Nanosecond: 1}}}, time.Nanosecond},
+	} {
+		if got := r.OverlapDuration(tc.other); got != tc.want {
+			t.Errorf("OverlapDuration(%v) = %v, want %v", tc.other, got, tc.want)
+		}
+		if got := tc.other.OverlapDuration(r); got != tc.want {
+			t.Errorf("OverlapDuration is not symmetric for %v: %v, want %v", tc.other, got, tc.want)
+		}
+	}
+}
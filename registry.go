@@ -0,0 +1,36 @@
+package bigqueryGoDate
+
+import (
+	"reflect"
+	"sync"
+)
+
+var (
+	scanTypesMu sync.RWMutex
+	scanTypes   = map[reflect.Type]func(any) (Date, bool){}
+)
+
+// RegisterScanType registers a converter that Date.Scan uses for values of
+// dynamic type t that it does not otherwise support, such as wrapper types
+// defined by an ORM. The converter receives the scanned value and reports
+// whether it could produce a Date; if not, Scan returns its usual error.
+// Lookup is by exact type, so registering MyDate does not cover *MyDate.
+// Registering a type again replaces its converter. RegisterScanType is
+// safe for concurrent use, but is typically called from an init function.
+func RegisterScanType(t reflect.Type, conv func(any) (Date, bool)) {
+	scanTypesMu.Lock()
+	defer scanTypesMu.Unlock()
+	scanTypes[t] = conv
+}
+
+// scanRegistered converts value with the converter registered for its type.
+// It reports false if there is none or the converter declined.
+func scanRegistered(value any) (Date, bool) {
+	scanTypesMu.RLock()
+	conv, ok := scanTypes[reflect.TypeOf(value)]
+	scanTypesMu.RUnlock()
+	if !ok {
+		return Date{}, false
+	}
+	return conv(value)
+}
@@ -0,0 +1,49 @@
+package bigqueryGoDate
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// ormDate stands in for a date wrapper type defined by an ORM.
+type ormDate struct{ y, m, d int }
+
+func TestRegisterScanType(t *testing.T) {
+	var d Date
+	if err := d.Scan(ormDate{2023, 5, 1}); err == nil {
+		t.Fatal("Scan of an unregistered type succeeded, want an error")
+	}
+
+	typ := reflect.TypeOf(ormDate{})
+	defer func() {
+		scanTypesMu.Lock()
+		delete(scanTypes, typ)
+		scanTypesMu.Unlock()
+	}()
+	RegisterScanType(typ, func(v any) (Date, bool) {
+		o := v.(ormDate)
+		if o.y == 0 {
+			return Date{}, false
+		}
+		return Date{o.y, time.Month(o.m), o.d}, true
+	})
+
+	if err := d.Scan(ormDate{2023, 5, 1}); err != nil || d != (Date{2023, 5, 1}) {
+		t.Errorf("Scan(ormDate) = %v, %v; want 2023-05-01", d, err)
+	}
+	// A converter that declines gives the usual error.
+	if err := d.Scan(ormDate{}); err == nil {
+		t.Errorf("Scan of a declined value = %v, want an error", d)
+	}
+	// Lookup is by exact type.
+	if err := d.Scan(&ormDate{2023, 5, 1}); err == nil {
+		t.Errorf("Scan(*ormDate) = %v, want an error", d)
+	}
+
+	// Registering again replaces the converter.
+	RegisterScanType(typ, func(any) (Date, bool) { return Date{2000, 1, 1}, true })
+	if err := d.Scan(ormDate{2023, 5, 1}); err != nil || d != (Date{2000, 1, 1}) {
+		t.Errorf("Scan(ormDate) after re-registering = %v, %v; want 2000-01-01", d, err)
+	}
+}
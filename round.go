@@ -0,0 +1,49 @@
+package bigqueryGoDate
+
+import "time"
+
+// A RoundingMode selects how Time.RoundMode resolves values that are not a
+// multiple of the rounding interval.
+type RoundingMode int
+
+// The rounding modes supported by Time.RoundMode.
+const (
+	// HalfUp rounds to the nearest multiple, with halfway values rounded
+	// up. This matches time.Time.Round.
+	HalfUp RoundingMode = iota
+	// HalfEven rounds to the nearest multiple, with halfway values rounded
+	// to the even multiple (banker's rounding).
+	HalfEven
+	// Ceil rounds up to the next multiple.
+	Ceil
+	// Floor rounds down to the previous multiple, like time.Time.Truncate.
+	Floor
+)
+
+// RoundMode returns the result of rounding t to a multiple of d since
+// midnight, using the given mode. If d <= 0, t is returned unchanged. A
+// result of 24:00:00 or later wraps around to the start of the day, so
+// 23:59:45 rounded up to the minute is 00:00:00.
+func (t Time) RoundMode(d time.Duration, mode RoundingMode) Time {
+	if d <= 0 {
+		return t
+	}
+	ns := t.sinceMidnight()
+	q, r := ns/d, ns%d
+	switch mode {
+	case HalfUp:
+		if 2*r >= d {
+			q++
+		}
+	case HalfEven:
+		if 2*r > d || (2*r == d && q%2 == 1) {
+			q++
+		}
+	case Ceil:
+		if r > 0 {
+			q++
+		}
+	}
+	rounded, _ := timeSinceMidnight(int64(q*d%(24*time.Hour)), Nanoseconds)
+	return rounded
+}
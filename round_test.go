@@ -0,0 +1,42 @@
+package bigqueryGoDate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRoundMode(t *testing.T) {
+	for _, tc := range []struct {
+		t    Time
+		d    time.Duration
+		mode RoundingMode
+		want Time
+	}{
+		{Time{13, 45, 29, 0}, time.Minute, HalfUp, Time{13, 45, 0, 0}},
+		{Time{13, 45, 30, 0}, time.Minute, HalfUp, Time{13, 46, 0, 0}},
+		{Time{13, 44, 30, 0}, time.Minute, HalfUp, Time{13, 45, 0, 0}},
+		// Halfway values go to the even multiple.
+		{Time{13, 45, 30, 0}, time.Minute, HalfEven, Time{13, 46, 0, 0}},
+		{Time{13, 44, 30, 0}, time.Minute, HalfEven, Time{13, 44, 0, 0}},
+		{Time{13, 44, 31, 0}, time.Minute, HalfEven, Time{13, 45, 0, 0}},
+		{Time{13, 44, 29, 0}, time.Minute, HalfEven, Time{13, 44, 0, 0}},
+		{Time{0, 0, 0, 1500}, time.Microsecond, HalfEven, Time{0, 0, 0, 2000}},
+		{Time{0, 0, 0, 2500}, time.Microsecond, HalfEven, Time{0, 0, 0, 2000}},
+		{Time{13, 44, 0, 1}, time.Minute, Ceil, Time{13, 45, 0, 0}},
+		{Time{13, 44, 0, 0}, time.Minute, Ceil, Time{13, 44, 0, 0}},
+		{Time{13, 44, 59, 999999999}, time.Minute, Floor, Time{13, 44, 0, 0}},
+		{Time{13, 44, 0, 0}, 15 * time.Minute, Floor, Time{13, 30, 0, 0}},
+		{Time{13, 38, 0, 0}, 15 * time.Minute, HalfUp, Time{13, 45, 0, 0}},
+		// Results of 24:00:00 wrap to midnight.
+		{Time{23, 59, 45, 0}, time.Minute, HalfUp, Time{}},
+		{Time{23, 59, 0, 1}, time.Minute, Ceil, Time{}},
+		{Time{23, 0, 0, 0}, 24 * time.Hour, HalfUp, Time{}},
+		// A non-positive interval leaves t unchanged.
+		{Time{13, 44, 31, 5}, 0, HalfUp, Time{13, 44, 31, 5}},
+		{Time{13, 44, 31, 5}, -time.Minute, Floor, Time{13, 44, 31, 5}},
+	} {
+		if got := tc.t.RoundMode(tc.d, tc.mode); got != tc.want {
+			t.Errorf("%v.RoundMode(%v, %d) = %v, want %v", tc.t, tc.d, tc.mode, got, tc.want)
+		}
+	}
+}
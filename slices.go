@@ -0,0 +1,48 @@
+package bigqueryGoDate
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+)
+
+// ScanDates scans a REPEATED DATE value into dst. v may be nil, which sets
+// dst to nil, or any slice whose elements Date.Scan accepts, such as a
+// []string, the []interface{} produced by decoding the bq CLI's JSON output,
+// or the []bigquery.Value returned by the BigQuery client. On error, dst is
+// left unchanged.
+func ScanDates(dst *[]Date, v any) error {
+	return scanSlice(dst, v)
+}
+
+// ScanDateTimes scans a REPEATED DATETIME value into dst. v may be nil,
+// which sets dst to nil, or any slice whose elements DateTime.Scan accepts,
+// such as the []bigquery.Value of civil.DateTime returned by the BigQuery
+// client or a slice of strings. On error, dst is left unchanged.
+func ScanDateTimes(dst *[]DateTime, v any) error {
+	return scanSlice(dst, v)
+}
+
+// scanSlice scans each element of the slice v into a new []T and stores it
+// in dst.
+func scanSlice[T any, PT interface {
+	*T
+	sql.Scanner
+}](dst *[]T, v any) error {
+	if v == nil {
+		*dst = nil
+		return nil
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return fmt.Errorf("unsupported scan type for %T: %T", *dst, v)
+	}
+	s := make([]T, rv.Len())
+	for i := range s {
+		if err := PT(&s[i]).Scan(rv.Index(i).Interface()); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+	*dst = s
+	return nil
+}
@@ -0,0 +1,78 @@
+package bigqueryGoDate
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+)
+
+func TestScanDates(t *testing.T) {
+	want := []Date{{2023, 5, 1}, {2024, 2, 29}}
+	for _, v := range []any{
+		[]string{"2023-05-01", "2024-02-29"},
+		[]any{"2023-05-01", "2024-02-29"},
+		[]civil.Date{{Year: 2023, Month: 5, Day: 1}, {Year: 2024, Month: 2, Day: 29}},
+		[]time.Time{time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC)},
+	} {
+		var got []Date
+		if err := ScanDates(&got, v); err != nil || !slices.Equal(got, want) {
+			t.Errorf("ScanDates(%T) = %v, %v; want %v", v, got, err, want)
+		}
+	}
+
+	got := []Date{{2023, 5, 1}}
+	if err := ScanDates(&got, nil); err != nil || got != nil {
+		t.Errorf("ScanDates(nil) = %v, %v; want nil", got, err)
+	}
+	if err := ScanDates(&got, []string{}); err != nil || got == nil || len(got) != 0 {
+		t.Errorf("ScanDates([]string{}) = %#v, %v; want an empty slice", got, err)
+	}
+}
+
+func TestScanDatesErrors(t *testing.T) {
+	before := []Date{{2023, 5, 1}}
+	got := before
+	if err := ScanDates(&got, "2023-05-01"); err == nil {
+		t.Error("ScanDates of a string succeeded, want an error")
+	}
+	err := ScanDates(&got, []string{"2023-05-01", "2023-05-01", "not a date"})
+	if err == nil || !strings.HasPrefix(err.Error(), "element 2: ") {
+		t.Errorf("ScanDates with a bad third element = %v, want an error naming element 2", err)
+	}
+	if !slices.Equal(got, before) {
+		t.Errorf("ScanDates changed dst to %v on error", got)
+	}
+}
+
+func TestScanDateTimes(t *testing.T) {
+	want := []DateTime{
+		{Date{2023, 5, 1}, Time{13, 45, 0, 0}},
+		{Date{2023, 5, 2}, Time{0, 0, 0, 500000000}},
+	}
+	for _, v := range []any{
+		[]string{"2023-05-01T13:45:00", "2023-05-02T00:00:00.5"},
+		[]any{
+			civil.DateTime{Date: civil.Date{Year: 2023, Month: 5, Day: 1}, Time: civil.Time{Hour: 13, Minute: 45}},
+			civil.DateTime{Date: civil.Date{Year: 2023, Month: 5, Day: 2}, Time: civil.Time{Nanosecond: 500000000}},
+		},
+	} {
+		var got []DateTime
+		if err := ScanDateTimes(&got, v); err != nil || !slices.Equal(got, want) {
+			t.Errorf("ScanDateTimes(%T) = %v, %v; want %v", v, got, err, want)
+		}
+	}
+
+	got := want
+	if err := ScanDateTimes(&got, nil); err != nil || got != nil {
+		t.Errorf("ScanDateTimes(nil) = %v, %v; want nil", got, err)
+	}
+	if err := ScanDateTimes(&got, []any{"2023-05-01T13:45:00", 3.5}); err == nil || !strings.HasPrefix(err.Error(), "element 1: ") {
+		t.Errorf("ScanDateTimes with a bad second element = %v, want an error naming element 1", err)
+	}
+	if err := ScanDateTimes(&got, 42); err == nil {
+		t.Error("ScanDateTimes of an int succeeded, want an error")
+	}
+}
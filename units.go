@@ -0,0 +1,125 @@
+package bigqueryGoDate
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// A TimeUnit is the resolution used to interpret integer values.
+type TimeUnit int
+
+// The units understood by the integer decoders in this package.
+const (
+	Nanoseconds TimeUnit = iota
+	Microseconds
+	Milliseconds
+	Seconds
+)
+
+// Duration returns the length of one unit.
+func (u TimeUnit) Duration() time.Duration {
+	switch u {
+	case Microseconds:
+		return time.Microsecond
+	case Milliseconds:
+		return time.Millisecond
+	case Seconds:
+		return time.Second
+	default:
+		return time.Nanosecond
+	}
+}
+
+// String returns the name of the unit.
+func (u TimeUnit) String() string {
+	switch u {
+	case Microseconds:
+		return "microseconds"
+	case Milliseconds:
+		return "milliseconds"
+	case Seconds:
+		return "seconds"
+	default:
+		return "nanoseconds"
+	}
+}
+
+// TimeScanUnit is the unit Time.Scan uses to interpret int64 values as a
+// count since midnight. It defaults to Microseconds, the precision of
+// BigQuery's TIME type; set it to Nanoseconds for sources such as Arrow or
+// Parquet TIME(NANOS). Scanning with the wrong unit silently corrupts the
+// result, so it should match the producer of the column.
+var TimeScanUnit = Microseconds
+
+// TimeFromNanos returns the Time that is n nanoseconds after midnight.
+// It returns an error if n is outside the range [0, 86400e9).
+func TimeFromNanos(n int64) (Time, error) {
+	return timeSinceMidnight(n, Nanoseconds)
+}
+
+// timeSinceMidnight returns the Time that is n units after midnight, or an
+// error if that is not within a single day.
+func timeSinceMidnight(n int64, unit TimeUnit) (Time, error) {
+	per := unit.Duration()
+	if n < 0 || n >= int64(24*time.Hour/per) {
+		return Time{}, fmt.Errorf("%d %v since midnight out of range for Time", n, unit)
+	}
+	ns := time.Duration(n) * per
+	return Time{
+		Hour:       int(ns / time.Hour),
+		Minute:     int(ns % time.Hour / time.Minute),
+		Second:     int(ns % time.Minute / time.Second),
+		Nanosecond: int(ns % time.Second),
+	}, nil
+}
+
+// TimeFromSeconds returns the Time that is sec seconds after midnight, such
+// as 49500.5 for 13:45:00.5. The fractional part is rounded to the nearest
+// nanosecond, halfway values away from zero. It returns an error if sec is
+// outside the range [0, 86400) or rounds up to 86400.
+func TimeFromSeconds(sec float64) (Time, error) {
+	if !(sec >= 0 && sec < 86400) {
+		return Time{}, fmt.Errorf("%v seconds since midnight out of range for Time", sec)
+	}
+	return timeSinceMidnight(int64(math.Round(sec*1e9)), Nanoseconds)
+}
+
+// DateTimeScanUnit is the unit DateTime.Scan uses to interpret int64
+// values as a count since DateTimeScanEpoch. It defaults to
+// Microseconds, the precision of BigQuery's DATETIME type. A mismatch with
+// the producer of the column is usually off by a factor of 1000 and is
+// caught by Scan's check that the result falls within the years 1 to 9999.
+var DateTimeScanUnit = Microseconds
+
+// DateTimeScanEpoch is the origin from which DateTime.Scan counts int64
+// values, in units of DateTimeScanUnit. It defaults to the Unix epoch,
+// 1970-01-01T00:00:00; drivers that count from another origin, such as
+// 2000-01-01 for PostgreSQL's binary timestamps, can set it accordingly.
+var DateTimeScanEpoch = DateTime{Date: unixEpoch}
+
+// DateTimeFromUnix returns the DateTime, in UTC, that is n units after
+// 1970-01-01T00:00:00. It returns an error if the result falls outside the
+// years 1 to 9999 supported by BigQuery, which usually means the value was
+// recorded in a different unit.
+func DateTimeFromUnix(n int64, unit TimeUnit) (DateTime, error) {
+	return dateTimeSinceEpoch(n, unit, DateTime{Date: unixEpoch})
+}
+
+// dateTimeSinceEpoch returns the DateTime that is n units after epoch, or
+// an error if that is not within the years 1 to 9999. n is split into whole
+// seconds before it is added, so no unit can overflow time.Duration.
+func dateTimeSinceEpoch(n int64, unit TimeUnit, epoch DateTime) (DateTime, error) {
+	per := unit.Duration()
+	perSec := int64(time.Second / per)
+	sec, frac := n/perSec, n%perSec
+	base := epoch.In(time.UTC)
+	if (sec > 0 && base.Unix() > math.MaxInt64-sec) || (sec < 0 && base.Unix() < math.MinInt64-sec) {
+		return DateTime{}, fmt.Errorf("%d %v since %v out of range for DateTime", n, unit, epoch)
+	}
+	t := time.Unix(base.Unix()+sec, int64(base.Nanosecond())+frac*int64(per)).UTC()
+	if t.Year() < 1 || t.Year() > 9999 {
+		return DateTime{}, fmt.Errorf("%d %v since %v out of range for DateTime", n, unit, epoch)
+	}
+	return DateTimeOf(t), nil
+}
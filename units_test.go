@@ -0,0 +1,165 @@
+package bigqueryGoDate
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestScanIntMatchesString(t *testing.T) {
+	var fromString, fromInt DateTime
+	if err := fromString.Scan("2023-05-01T12:34:56.789012"); err != nil {
+		t.Fatal(err)
+	}
+	if err := fromInt.Scan(fromString.In(time.UTC).UnixMicro()); err != nil {
+		t.Fatal(err)
+	}
+	if fromInt != fromString {
+		t.Errorf("DateTime.Scan(int64) = %v, want %v", fromInt, fromString)
+	}
+
+	var tString, tInt Time
+	if err := tString.Scan("12:34:56.789012"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tInt.Scan(int64(45296789012)); err != nil {
+		t.Fatal(err)
+	}
+	if tInt != tString {
+		t.Errorf("Time.Scan(int64) = %v, want %v", tInt, tString)
+	}
+}
+
+func TestDateTimeScanEpoch(t *testing.T) {
+	defer func(e DateTime) { DateTimeScanEpoch = e }(DateTimeScanEpoch)
+	DateTimeScanEpoch = DateTime{Date: Date{2000, 1, 1}}
+
+	var dt DateTime
+	if err := dt.Scan(int64(-1)); err != nil {
+		t.Fatal(err)
+	}
+	if want := (DateTime{Date{1999, 12, 31}, Time{23, 59, 59, 999999000}}); dt != want {
+		t.Errorf("Scan(-1) with a 2000-01-01 epoch = %v, want %v", dt, want)
+	}
+	for _, n := range []int64{math.MaxInt64, math.MinInt64} {
+		if err := dt.Scan(n); err == nil {
+			t.Errorf("Scan(%d) = %v, want an out of range error", n, dt)
+		}
+	}
+}
+
+func TestDateTimeFromUnix(t *testing.T) {
+	want := DateTime{Date{2023, 5, 1}, Time{12, 34, 56, 0}}
+	sec := want.In(time.UTC).Unix()
+	for _, tc := range []struct {
+		n    int64
+		unit TimeUnit
+	}{
+		{sec, Seconds},
+		{sec * 1e3, Milliseconds},
+		{sec * 1e6, Microseconds},
+		{sec * 1e9, Nanoseconds},
+	} {
+		if got, err := DateTimeFromUnix(tc.n, tc.unit); err != nil || got != want {
+			t.Errorf("DateTimeFromUnix(%d, %v) = %v, %v; want %v", tc.n, tc.unit, got, err, want)
+		}
+	}
+	if _, err := DateTimeFromUnix(math.MaxInt64, Seconds); err == nil {
+		t.Error("DateTimeFromUnix(MaxInt64, Seconds) succeeded, want an error")
+	}
+	if _, err := DateTimeFromUnix(sec*1e6, Seconds); err == nil {
+		t.Error("DateTimeFromUnix of microseconds read as seconds succeeded, want an error")
+	}
+}
+
+func TestDateTimeScanUnit(t *testing.T) {
+	defer func(u TimeUnit) { DateTimeScanUnit = u }(DateTimeScanUnit)
+	want := DateTime{Date{2023, 5, 1}, Time{12, 34, 56, 0}}
+	sec := want.In(time.UTC).Unix()
+	for _, tc := range []struct {
+		unit TimeUnit
+		n    int64
+	}{
+		{Seconds, sec},
+		{Milliseconds, sec * 1e3},
+		{Microseconds, sec * 1e6},
+		{Nanoseconds, sec * 1e9},
+	} {
+		DateTimeScanUnit = tc.unit
+		var got DateTime
+		if err := got.Scan(tc.n); err != nil || got != want {
+			t.Errorf("Scan(%d) in %v = %v, %v; want %v", tc.n, tc.unit, got, err, want)
+		}
+	}
+	// Milliseconds read as seconds are far outside the DATETIME range.
+	DateTimeScanUnit = Seconds
+	var got DateTime
+	if err := got.Scan(sec * 1e3); err == nil {
+		t.Errorf("Scan(%d) in seconds = %v, want an out of range error", sec*1e3, got)
+	}
+}
+
+func TestTimeFromNanos(t *testing.T) {
+	for _, tc := range []struct {
+		n    int64
+		want Time
+		ok   bool
+	}{
+		{0, Time{}, true},
+		{49500e9 + 5, Time{13, 45, 0, 5}, true},
+		{86400e9 - 1, Time{23, 59, 59, 999999999}, true},
+		{86400e9, Time{}, false},
+		{-1, Time{}, false},
+	} {
+		got, err := TimeFromNanos(tc.n)
+		if (err == nil) != tc.ok || got != tc.want {
+			t.Errorf("TimeFromNanos(%d) = %v, %v; want %v, ok=%v", tc.n, got, err, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestTimeFromSeconds(t *testing.T) {
+	for _, tc := range []struct {
+		sec  float64
+		want Time
+		ok   bool
+	}{
+		{0, Time{}, true},
+		{49500.5, Time{13, 45, 0, 500000000}, true},
+		{49500.25, Time{13, 45, 0, 250000000}, true},
+		{86399.999999999, Time{23, 59, 59, 999999999}, true},
+		{86399.9999999999, Time{}, false}, // rounds up to 86400
+		{86400, Time{}, false},
+		{-0.5, Time{}, false},
+		{math.NaN(), Time{}, false},
+		{math.Inf(1), Time{}, false},
+	} {
+		got, err := TimeFromSeconds(tc.sec)
+		if (err == nil) != tc.ok || got != tc.want {
+			t.Errorf("TimeFromSeconds(%v) = %v, %v; want %v, ok=%v", tc.sec, got, err, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestTimeScanUnit(t *testing.T) {
+	defer func(u TimeUnit) { TimeScanUnit = u }(TimeScanUnit)
+	want := Time{13, 45, 0, 0}
+	for _, tc := range []struct {
+		unit TimeUnit
+		n    int64
+	}{
+		{Nanoseconds, 49500e9},
+		{Microseconds, 49500e6},
+		{Milliseconds, 49500e3},
+		{Seconds, 49500},
+	} {
+		TimeScanUnit = tc.unit
+		var got Time
+		if err := got.Scan(tc.n); err != nil || got != want {
+			t.Errorf("Scan(%d) in %v = %v, %v; want %v", tc.n, tc.unit, got, err, want)
+		}
+		if err := got.Scan(tc.n * 2); err == nil {
+			t.Errorf("Scan(%d) in %v = %v, want an out of range error", tc.n*2, tc.unit, got)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package bigqueryGoDate
+
+import (
+	"fmt"
+	"time"
+)
+
+// Weekday returns the day of the week on which d falls.
+func (d Date) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+// ISOWeek returns the ISO 8601 year and week number in which d occurs.
+// Week ranges from 1 to 53. Jan 01 to Jan 03 of year n might belong to
+// week 52 or 53 of year n-1, and Dec 29 to Dec 31 might belong to week 1
+// of year n+1; for example, 2021-01-01 is in week 53 of 2020.
+func (d Date) ISOWeek() (year, week int) {
+	return d.In(time.UTC).ISOWeek()
+}
+
+// ParseISOWeekDate parses a string in the ISO 8601 week-date format
+//
+//	YYYY-Www-D
+//
+// and returns the date it represents. For example, "2023-W18-1" is the Monday
+// of ISO week 18 of 2023 (2023-05-01). Week numbers run from 1 to 52 or 53,
+// depending on the ISO year, and days from 1 (Monday) to 7 (Sunday). The
+// returned date may fall in the adjacent calendar year.
+func ParseISOWeekDate(s string) (Date, error) {
+	if len(s) != 10 || s[4] != '-' || s[5] != 'W' || s[8] != '-' {
+		return Date{}, fmt.Errorf("invalid ISO week date %q", s)
+	}
+	year, ok1 := atoi(s[0:4])
+	week, ok2 := atoi(s[6:8])
+	day, ok3 := atoi(s[9:10])
+	if !ok1 || !ok2 || !ok3 {
+		return Date{}, fmt.Errorf("invalid ISO week date %q", s)
+	}
+	if week < 1 || week > ISOWeeksInYear(year) {
+		return Date{}, fmt.Errorf("invalid ISO week date %q: week out of range", s)
+	}
+	if day < 1 || day > 7 {
+		return Date{}, fmt.Errorf("invalid ISO week date %q: day out of range", s)
+	}
+	return isoWeekStart(year).AddDays((week-1)*7 + day - 1), nil
+}
+
+// ISOWeekDateString returns the date in the ISO 8601 week-date format
+// accepted by ParseISOWeekDate.
+func (d Date) ISOWeekDateString() string {
+	year, week := d.ISOWeek()
+	return fmt.Sprintf("%04d-W%02d-%d", year, week, isoWeekday(d))
+}
+
+// ISOWeeksInYear returns the number of weeks, 52 or 53, in the ISO year
+// containing d, which may differ from d.Year near January 1st.
+func (d Date) ISOWeeksInYear() int {
+	year, _ := d.ISOWeek()
+	return ISOWeeksInYear(year)
+}
+
+// StartOfWeek returns the first day of the week containing d, for weeks
+// that begin on weekStart. Use time.Monday or time.Sunday for the usual
+// conventions.
+func (d Date) StartOfWeek(weekStart time.Weekday) Date {
+	return d.AddDays(-((int(d.Weekday()) - int(weekStart) + 7) % 7))
+}
+
+// StartOfISOWeek returns the Monday of the ISO 8601 week containing d.
+func (d Date) StartOfISOWeek() Date {
+	return d.AddDays(1 - isoWeekday(d))
+}
+
+// EndOfISOWeek returns the Sunday of the ISO 8601 week containing d.
+func (d Date) EndOfISOWeek() Date {
+	return d.AddDays(7 - isoWeekday(d))
+}
+
+// isoWeekStart returns the Monday of ISO week 1 of the given ISO year.
+// Week 1 is the week containing January 4th.
+func isoWeekStart(year int) Date {
+	return Date{Year: year, Month: time.January, Day: 4}.StartOfISOWeek()
+}
+
+// ISOWeeksInYear returns the number of weeks, 52 or 53, in the given ISO
+// 8601 week-numbering year. Long years are those that start on a Thursday,
+// and leap years that start on a Wednesday.
+func ISOWeeksInYear(isoYear int) int {
+	// December 28th always falls in the last week of its ISO year.
+	_, week := Date{Year: isoYear, Month: time.December, Day: 28}.ISOWeek()
+	return week
+}
+
+// isoWeekday returns the ISO 8601 day of the week of d, from 1 (Monday)
+// to 7 (Sunday).
+func isoWeekday(d Date) int {
+	wd := int(d.Weekday())
+	if wd == 0 {
+		return 7
+	}
+	return wd
+}
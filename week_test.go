@@ -0,0 +1,162 @@
+package bigqueryGoDate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestISOWeekDate(t *testing.T) {
+	for _, tc := range []struct {
+		d        Date
+		weekDate string
+	}{
+		{Date{2023, 5, 1}, "2023-W18-1"},
+		// January days that belong to the last week of the previous year.
+		{Date{2021, 1, 1}, "2020-W53-5"},
+		{Date{2021, 1, 3}, "2020-W53-7"},
+		{Date{2022, 1, 1}, "2021-W52-6"},
+		{Date{2021, 1, 4}, "2021-W01-1"},
+		// December days that belong to the first week of the next year.
+		{Date{2024, 12, 30}, "2025-W01-1"},
+		{Date{2019, 12, 31}, "2020-W01-2"},
+		{Date{2020, 12, 31}, "2020-W53-4"},
+	} {
+		if got := tc.d.ISOWeekDateString(); got != tc.weekDate {
+			t.Errorf("%v.ISOWeekDateString() = %q, want %q", tc.d, got, tc.weekDate)
+		}
+		if got, err := ParseISOWeekDate(tc.weekDate); err != nil || got != tc.d {
+			t.Errorf("ParseISOWeekDate(%q) = %v, %v; want %v", tc.weekDate, got, err, tc.d)
+		}
+	}
+}
+
+func TestParseISOWeekDateErrors(t *testing.T) {
+	for _, s := range []string{
+		"", "2023-W18", "2023W181", "2023-w18-1", "2023-W00-1", "2023-W53-1",
+		"2023-W18-0", "2023-W18-8", "2023-W1a-1", "+023-W18-1",
+	} {
+		if d, err := ParseISOWeekDate(s); err == nil {
+			t.Errorf("ParseISOWeekDate(%q) = %v, want an error", s, d)
+		}
+	}
+}
+
+func TestWeekdayAndISOWeek(t *testing.T) {
+	for _, tc := range []struct {
+		d       Date
+		weekday time.Weekday
+		year    int
+		week    int
+	}{
+		{Date{2023, 5, 1}, time.Monday, 2023, 18},
+		// January days that belong to the last week of the previous year.
+		{Date{2021, 1, 1}, time.Friday, 2020, 53},
+		{Date{2021, 1, 3}, time.Sunday, 2020, 53},
+		{Date{2022, 1, 1}, time.Saturday, 2021, 52},
+		{Date{2021, 1, 4}, time.Monday, 2021, 1},
+		// December days that belong to the first week of the next year.
+		{Date{2024, 12, 30}, time.Monday, 2025, 1},
+		{Date{2019, 12, 31}, time.Tuesday, 2020, 1},
+		{Date{2020, 12, 31}, time.Thursday, 2020, 53},
+	} {
+		if got := tc.d.Weekday(); got != tc.weekday {
+			t.Errorf("%v.Weekday() = %v, want %v", tc.d, got, tc.weekday)
+		}
+		if y, w := tc.d.ISOWeek(); y != tc.year || w != tc.week {
+			t.Errorf("%v.ISOWeek() = %d, %d; want %d, %d", tc.d, y, w, tc.year, tc.week)
+		}
+	}
+}
+
+func TestISOWeeksInYear(t *testing.T) {
+	for _, tc := range []struct {
+		year, weeks int
+	}{
+		{2015, 53}, // starts on a Thursday
+		{2020, 53}, // leap year starting on a Wednesday
+		{2021, 52},
+		{2023, 52},
+		{2024, 52}, // leap year starting on a Monday
+		{2026, 53},
+	} {
+		if got := ISOWeeksInYear(tc.year); got != tc.weeks {
+			t.Errorf("ISOWeeksInYear(%d) = %d, want %d", tc.year, got, tc.weeks)
+		}
+	}
+	// Date.ISOWeeksInYear uses the ISO year, which differs from the
+	// calendar year in early January.
+	if got := (Date{2021, 1, 1}).ISOWeeksInYear(); got != 53 {
+		t.Errorf("Date{2021, 1, 1}.ISOWeeksInYear() = %d, want 53 (ISO year 2020)", got)
+	}
+	if got := (Date{2021, 1, 4}).ISOWeeksInYear(); got != 52 {
+		t.Errorf("Date{2021, 1, 4}.ISOWeeksInYear() = %d, want 52", got)
+	}
+}
+
+func TestStartOfISOWeek(t *testing.T) {
+	// The week of Wednesday 2023-05-03, and a week that spans a year
+	// boundary.
+	for _, tc := range []struct {
+		d          Date
+		start, end Date
+	}{
+		{Date{2023, 5, 1}, Date{2023, 5, 1}, Date{2023, 5, 7}},
+		{Date{2023, 5, 3}, Date{2023, 5, 1}, Date{2023, 5, 7}},
+		{Date{2023, 5, 6}, Date{2023, 5, 1}, Date{2023, 5, 7}},
+		{Date{2023, 5, 7}, Date{2023, 5, 1}, Date{2023, 5, 7}},
+		{Date{2021, 1, 1}, Date{2020, 12, 28}, Date{2021, 1, 3}},
+	} {
+		if got := tc.d.StartOfISOWeek(); got != tc.start {
+			t.Errorf("%v.StartOfISOWeek() = %v, want %v", tc.d, got, tc.start)
+		}
+		if got := tc.d.EndOfISOWeek(); got != tc.end {
+			t.Errorf("%v.EndOfISOWeek() = %v, want %v", tc.d, got, tc.end)
+		}
+	}
+}
+
+func TestStartOfWeek(t *testing.T) {
+	// The week of Wednesday 2023-05-03, and a week that spans a year
+	// boundary.
+	for _, tc := range []struct {
+		d              Date
+		monday, sunday Date
+	}{
+		{Date{2023, 5, 1}, Date{2023, 5, 1}, Date{2023, 4, 30}},
+		{Date{2023, 5, 3}, Date{2023, 5, 1}, Date{2023, 4, 30}},
+		{Date{2023, 5, 6}, Date{2023, 5, 1}, Date{2023, 4, 30}},
+		{Date{2023, 5, 7}, Date{2023, 5, 1}, Date{2023, 5, 7}},
+		{Date{2021, 1, 1}, Date{2020, 12, 28}, Date{2020, 12, 27}},
+	} {
+		if got := tc.d.StartOfWeek(time.Monday); got != tc.monday {
+			t.Errorf("%v.StartOfWeek(Monday) = %v, want %v", tc.d, got, tc.monday)
+		}
+		if got := tc.d.StartOfWeek(time.Sunday); got != tc.sunday {
+			t.Errorf("%v.StartOfWeek(Sunday) = %v, want %v", tc.d, got, tc.sunday)
+		}
+	}
+}
+
+func TestWeeksSince(t *testing.T) {
+	s := Date{2023, 5, 15}
+	for _, tc := range []struct {
+		days, want int
+	}{
+		{0, 0},
+		{6, 0},
+		{7, 1},
+		{13, 1},
+		{14, 2},
+		// Truncated toward zero in the past too.
+		{-6, 0},
+		{-7, -1},
+		{-13, -1},
+		{-14, -2},
+		{365, 52},
+	} {
+		d := s.AddDays(tc.days)
+		if got := d.WeeksSince(s); got != tc.want {
+			t.Errorf("%v.WeeksSince(%v) = %d, want %d", d, s, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+//go:build yaml
+
+package bigqueryGoDate
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// This file is only built with the "yaml" build tag, so that programs that
+// do not use gopkg.in/yaml.v3 do not have to depend on it.
+
+// MarshalYAML implements the yaml.Marshaler interface.
+// The output is d.String().
+func (d Date) MarshalYAML() (interface{}, error) {
+	return d.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface. It accepts a
+// scalar in a format accepted by ParseDate.
+func (d *Date) UnmarshalYAML(value *yaml.Node) error {
+	s, err := yamlScalar(value, "Date")
+	if err != nil {
+		return err
+	}
+	*d, err = ParseDate(s)
+	return err
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+// The output is t.String().
+func (t Time) MarshalYAML() (interface{}, error) {
+	return t.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface. It accepts a
+// scalar in a format accepted by ParseTime.
+func (t *Time) UnmarshalYAML(value *yaml.Node) error {
+	s, err := yamlScalar(value, "Time")
+	if err != nil {
+		return err
+	}
+	*t, err = ParseTime(s)
+	return err
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+// The output is dt.String().
+func (dt DateTime) MarshalYAML() (interface{}, error) {
+	return dt.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface. It accepts a
+// scalar in a format accepted by ParseDateTime.
+func (dt *DateTime) UnmarshalYAML(value *yaml.Node) error {
+	s, err := yamlScalar(value, "DateTime")
+	if err != nil {
+		return err
+	}
+	*dt, err = ParseDateTime(s)
+	return err
+}
+
+// yamlScalar returns the text of the scalar node value. Other node kinds
+// are an error naming the target type.
+func yamlScalar(value *yaml.Node, typ string) (string, error) {
+	if value.Kind != yaml.ScalarNode {
+		return "", fmt.Errorf("cannot unmarshal YAML node at line %d into %s: not a scalar", value.Line, typ)
+	}
+	return value.Value, nil
+}
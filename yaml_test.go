@@ -0,0 +1,59 @@
+//go:build yaml
+
+package bigqueryGoDate
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+type yamlRow struct {
+	D  Date     `yaml:"d"`
+	T  Time     `yaml:"t"`
+	DT DateTime `yaml:"dt"`
+}
+
+func TestYAMLRoundTrip(t *testing.T) {
+	in := yamlRow{
+		D:  Date{2023, 5, 1},
+		T:  Time{13, 45, 0, 500000000},
+		DT: DateTime{Date{2023, 5, 1}, Time{13, 45, 0, 0}},
+	}
+	data, err := yaml.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out yamlRow
+	if err := yaml.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out != in {
+		t.Errorf("round trip = %+v, want %+v", out, in)
+	}
+}
+
+func TestYAMLUnmarshal(t *testing.T) {
+	// Unquoted scalars that YAML would otherwise resolve as timestamps
+	// or sexagesimal numbers are parsed from their text.
+	var out yamlRow
+	if err := yaml.Unmarshal([]byte("d: 2023-05-01\nt: 13:45:00\ndt: 2023-05-01T13:45:00\n"), &out); err != nil {
+		t.Fatal(err)
+	}
+	if want := (yamlRow{Date{2023, 5, 1}, Time{Hour: 13, Minute: 45}, DateTime{Date{2023, 5, 1}, Time{Hour: 13, Minute: 45}}}); out != want {
+		t.Errorf("Unmarshal = %+v, want %+v", out, want)
+	}
+
+	// yaml.v3 leaves a field alone for a null without calling UnmarshalYAML.
+	if err := yaml.Unmarshal([]byte("d: null\nt: ~\n"), &out); err != nil || out.D != (Date{2023, 5, 1}) {
+		t.Errorf("Unmarshal of nulls = %+v, %v", out, err)
+	}
+
+	for _, data := range []string{
+		"d: 2023-02-30", "d: [2023, 5, 1]", "t: 25:00:00", "t: {hour: 13}", "dt: 2023-05-01X13:45:00",
+	} {
+		if err := yaml.Unmarshal([]byte(data), &out); err == nil {
+			t.Errorf("Unmarshal(%q) = %+v, want an error", data, out)
+		}
+	}
+}